@@ -17,10 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
 	"time"
 
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/metrics"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -36,8 +38,137 @@ type ReleaseSpec struct {
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +required
 	ReleasePlan string `json:"releasePlan"`
+
+	// DependsOn is a list of Releases in the same namespace that must succeed before this Release is processed
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Approvers is a list of users allowed to approve the Release. If set, the release PipelineRun is only created once
+	// one of them sets the approved-by annotation to their username. The approvers can't be changed once the Release
+	// is created
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+
+	// OverrideTarget is the namespace to release to instead of the target set in the ReleasePlan. It's only honored
+	// when target overrides are allowed in the controller and the Release has the override-target label set to true
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	OverrideTarget string `json:"overrideTarget,omitempty"`
+
+	// ReleaseStrategy is a reference to the ReleaseStrategy to use instead of the one set in the ReleasePlanAdmission,
+	// in the format namespace/name. Strategies can only be referenced from the shared namespaces allowed in the
+	// controller. The release PipelineRun is still created in the ReleasePlanAdmission namespace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	ReleaseStrategy string `json:"releaseStrategy,omitempty"`
+
+	// LabelsToResults maps label names to the names of release PipelineRun results. Once the release PipelineRun
+	// succeeds, the value of each result is written back to the Release as the value of its label
+	// +optional
+	LabelsToResults map[string]string `json:"labelsToResults,omitempty"`
+
+	// ResultsOutput is a Secret or ConfigMap in the Release namespace where the results of the release PipelineRun are
+	// written once it succeeds. The resource is owned by the Release, so it's garbage collected along with it. If a
+	// resource with the same name that isn't owned by the Release already exists, it's left untouched and the Release
+	// fails
+	// +optional
+	ResultsOutput *ResultsOutput `json:"resultsOutput,omitempty"`
+
+	// Labels are organizational labels added to the Release metadata on admission, so Releases can be queried by them.
+	// Labels using a prefix reserved to the platform are rejected
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TTLSecondsAfterSuccess is the time in seconds to keep the Release once it succeeded. The time is counted from
+	// the moment the Release finished deploying or, if it doesn't deploy, from its completion. A value of zero deletes
+	// the Release as soon as it finishes. If not set, the Release is kept indefinitely
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterSuccess *int32 `json:"ttlSecondsAfterSuccess,omitempty"`
+
+	// TTLSecondsAfterFailure is the time in seconds to keep the Release once it failed, counted from its completion.
+	// A value of zero deletes the Release as soon as it fails. If not set, the Release is kept indefinitely
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFailure *int32 `json:"ttlSecondsAfterFailure,omitempty"`
+
+	// NotifyOnlyOnFailure indicates whether the webhooks declared in the ReleaseStrategy and the notification channels
+	// of the Release should only be notified when the Release fails, suppressing the success notifications
+	// +optional
+	NotifyOnlyOnFailure bool `json:"notifyOnlyOnFailure,omitempty"`
+
+	// NotificationChannels is a list of destinations notified of the outcome of the Release once it finishes, in
+	// addition to the webhook declared in the ReleaseStrategy for that outcome. Only the endpoints pointing to the
+	// hosts allowed in the controller are notified
+	// +optional
+	NotificationChannels []NotificationChannel `json:"notificationChannels,omitempty"`
+
+	// Env holds environment-specific key/values passed to the release Pipeline as a json map in its env param. If the
+	// ReleaseStrategy also sets the env param to a json map, both are merged and the values of the Release win
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+
+	// Collectors is a list of tasks to run in a follow-on PipelineRun once the Release succeeds, so metadata about
+	// the Release can be collected without a separate ReleaseStrategy. The PipelineRun runs in the Release namespace
+	// with the default service account of that namespace. The names of the collectors must be unique
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Collectors []Collector `json:"collectors,omitempty"`
 }
 
+// Collector defines a task collecting metadata once the Release succeeds
+type Collector struct {
+	// Name is the name given to the task in the collectors PipelineRun
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Name string `json:"name"`
+
+	// Task is the name of the Task to run
+	// +required
+	Task string `json:"task"`
+
+	// Bundle is a reference to the Tekton bundle containing the Task. It must be hosted in one of the registries
+	// allowed in the controller. If not set, the Task is looked up in the Release namespace
+	// +optional
+	Bundle string `json:"bundle,omitempty"`
+}
+
+// NotificationChannel defines a destination notified of the outcome of a Release
+type NotificationChannel struct {
+	// Type is the kind of destination, either webhook, which receives the json payload of the notification, or slack,
+	// which receives a message built from it
+	// +kubebuilder:validation:Enum=webhook;slack
+	// +required
+	Type string `json:"type"`
+
+	// Endpoint is the url where the notification will be posted
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	// +required
+	Endpoint string `json:"endpoint"`
+}
+
+// ResultsOutput defines the resource where the results of the release PipelineRun are written
+type ResultsOutput struct {
+	// Kind is the kind of the resource, either Secret, for results holding sensitive data, or ConfigMap
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the resource. It's created if it doesn't exist
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	// +required
+	Name string `json:"name"`
+}
+
+const (
+	// ResultsOutputKindConfigMap is the ResultsOutput kind used to write the results to a ConfigMap
+	ResultsOutputKindConfigMap = "ConfigMap"
+
+	// ResultsOutputKindSecret is the ResultsOutput kind used to write the results to a Secret
+	ResultsOutputKindSecret = "Secret"
+)
+
 // ReleaseReason represents a reason for the release "Succeeded" condition.
 type ReleaseReason string
 
@@ -45,23 +176,182 @@ const (
 	// releaseConditionType is the type used when setting a release status condition
 	releaseConditionType string = "Succeeded"
 
+	// provenanceConditionType is the type used when setting the release provenance status condition
+	provenanceConditionType string = "ProvenanceVerified"
+
+	// matchedViaFallbackConditionType is the type used when setting the release fallback target match status condition
+	matchedViaFallbackConditionType string = "MatchedViaFallback"
+
+	// metadataCollectedConditionType is the type used when setting the metadata collection status condition
+	metadataCollectedConditionType string = "MetadataCollected"
+
+	// validatedConditionType is the type used when setting the release validation status condition
+	validatedConditionType string = "Validated"
+
+	// pipelineRunTamperedConditionType is the type used when setting the release PipelineRun tampering status condition
+	pipelineRunTamperedConditionType string = "PipelineRunTampered"
+
+	// pausedConditionType is the type used when setting the release reconciliation pause status condition
+	pausedConditionType string = "Paused"
+
+	// workspaceStorageBindingConditionType is the type used when setting the release PipelineRun workspace storage
+	// binding status condition
+	workspaceStorageBindingConditionType string = "WorkspaceStorageBinding"
+
 	// ReleaseReasonValidationError is the reason set when the Release validation failed
 	ReleaseReasonValidationError ReleaseReason = "ReleaseValidationError"
 
+	// ReleaseReasonApplicationMismatch is the reason set when the application label of the Release doesn't match the
+	// application of its ReleasePlan
+	ReleaseReasonApplicationMismatch ReleaseReason = "ApplicationMismatch"
+
+	// ReleaseReasonCollectorsFailed is the reason set when the collectors PipelineRun failed
+	ReleaseReasonCollectorsFailed ReleaseReason = "CollectorsFailed"
+
+	// ReleaseReasonCollectorsRunning is the reason set when the collectors PipelineRun starts running
+	ReleaseReasonCollectorsRunning ReleaseReason = "CollectorsRunning"
+
+	// ReleaseReasonCollectorsSucceeded is the reason set when the collectors PipelineRun succeeded
+	ReleaseReasonCollectorsSucceeded ReleaseReason = "CollectorsSucceeded"
+
+	// ReleaseReasonDependencyCycleDetected is the reason set when the Release dependencies contain a cycle
+	ReleaseReasonDependencyCycleDetected ReleaseReason = "DependencyCycleDetected"
+
+	// ReleaseReasonDependencyFailed is the reason set when one of the Releases this Release depends on failed
+	ReleaseReasonDependencyFailed ReleaseReason = "DependencyFailed"
+
+	// ReleaseReasonDryRun is the reason set when the release PipelineRun of a Release in dry-run mode was rendered
+	// without being created
+	ReleaseReasonDryRun ReleaseReason = "DryRun"
+
+	// ReleaseReasonDisallowedBundleRegistry is the reason set when the Release uses a Tekton bundle from a registry
+	// that is not allowed in the controller
+	ReleaseReasonDisallowedBundleRegistry ReleaseReason = "DisallowedBundleRegistry"
+
+	// ReleaseReasonInsufficientQuota is the reason set when the target namespace doesn't have enough quota left to run
+	// the release PipelineRun
+	ReleaseReasonInsufficientQuota ReleaseReason = "InsufficientQuota"
+
+	// ReleaseReasonParamsTooLarge is the reason set when the release PipelineRun params exceed the allowed size
+	ReleaseReasonParamsTooLarge ReleaseReason = "ParamsTooLarge"
+
+	// ReleaseReasonPipelineCancelled is the reason set when the release PipelineRun was cancelled externally
+	ReleaseReasonPipelineCancelled ReleaseReason = "ReleasePipelineCancelled"
+
+	// ReleaseReasonPipelineCancelling is the reason set when the release PipelineRun is being cancelled externally
+	ReleaseReasonPipelineCancelling ReleaseReason = "ReleasePipelineCancelling"
+
+	// ReleaseReasonPipelineCreationFailed is the reason set when the release PipelineRun is rejected by the API server
+	ReleaseReasonPipelineCreationFailed ReleaseReason = "ReleasePipelineCreationFailed"
+
 	// ReleaseReasonPipelineFailed is the reason set when the release PipelineRun failed
 	ReleaseReasonPipelineFailed ReleaseReason = "ReleasePipelineFailed"
 
+	// ReleaseReasonPipelineRunMissing is the reason set when the release PipelineRun of a running Release no longer
+	// exists
+	ReleaseReasonPipelineRunMissing ReleaseReason = "ReleasePipelineRunMissing"
+
+	// ReleaseReasonPipelineRefChanged is the reason set when the release PipelineRun was modified to reference a
+	// different pipeline than the one it was created with
+	ReleaseReasonPipelineRefChanged ReleaseReason = "PipelineRefChanged"
+
+	// ReleaseReasonPaused is the reason set when the reconciliation of the Release is paused by the pause annotation
+	ReleaseReasonPaused ReleaseReason = "Paused"
+
+	// ReleaseReasonPipelinePaused is the reason set when the release PipelineRun was paused externally
+	ReleaseReasonPipelinePaused ReleaseReason = "ReleasePipelinePaused"
+
+	// ReleaseReasonReleaseStrategyArtifactError is the reason set when the ReleaseStrategy artifact referenced by the
+	// ReleasePlanAdmission can't be fetched or parsed
+	ReleaseReasonReleaseStrategyArtifactError ReleaseReason = "ReleaseStrategyArtifactError"
+
+	// ReleaseReasonReleaseStrategyNotFound is the reason set when the ReleaseStrategy referenced by the Release or its
+	// ReleasePlanAdmission doesn't exist
+	ReleaseReasonReleaseStrategyNotFound ReleaseReason = "ReleaseStrategyNotFound"
+
+	// ReleaseReasonReleasePlanAdmissionNotFound is the reason set when no ReleasePlanAdmission matches the ReleasePlan
+	// of the Release
+	ReleaseReasonReleasePlanAdmissionNotFound ReleaseReason = "ReleasePlanAdmissionNotFound"
+
+	// ReleaseReasonReleasePlanNotFound is the reason set when the ReleasePlan referenced by the Release doesn't exist
+	ReleaseReasonReleasePlanNotFound ReleaseReason = "ReleasePlanNotFound"
+
 	// ReleaseReasonReleasePlanValidationError is the reason set when there is a validation error with the ReleasePlan
 	ReleaseReasonReleasePlanValidationError ReleaseReason = "ReleasePlanValidationError"
 
+	// ReleaseReasonTargetNamespaceNotFound is the reason set when the namespace targeted by the ReleasePlan doesn't exist
+	ReleaseReasonTargetNamespaceNotFound ReleaseReason = "TargetNamespaceNotFound"
+
+	// ReleaseReasonTargetNamespaceSaturated is the reason set when the Release is waiting for the PipelineRuns managed
+	// by the release service in the target namespace to drop below the namespace-wide maximum
+	ReleaseReasonTargetNamespaceSaturated ReleaseReason = "TargetNamespaceSaturated"
+
+	// ReleaseReasonTargetNotFound is the reason set when no ReleasePlanAdmission matches the ReleasePlan target and
+	// the ReleasePlanAdmission was found by matching the application alone
+	ReleaseReasonTargetNotFound ReleaseReason = "TargetNotFound"
+
+	// ReleaseReasonTargetOverrideNotAllowed is the reason set when the Release overrides its target but it's not
+	// allowed to do so
+	ReleaseReasonTargetOverrideNotAllowed ReleaseReason = "TargetOverrideNotAllowed"
+
 	// ReleaseReasonTargetDisabledError is the reason set when releases to the target are disabled
 	ReleaseReasonTargetDisabledError ReleaseReason = "ReleaseTargetDisabledError"
 
+	// ReleaseReasonReleaseTooSoon is the reason set when the Release is waiting for the minimum interval since the last
+	// successful Release of the same application to elapse
+	ReleaseReasonReleaseTooSoon ReleaseReason = "ReleaseTooSoon"
+
+	// ReleaseReasonProvenanceMissing is the reason set when the release PipelineRun provenance didn't appear within
+	// the grace period
+	ReleaseReasonProvenanceMissing ReleaseReason = "ProvenanceMissing"
+
+	// ReleaseReasonProvenanceVerified is the reason set when the release PipelineRun provenance was found
+	ReleaseReasonProvenanceVerified ReleaseReason = "ProvenanceVerified"
+
+	// ReleaseReasonReleaseStrategyNotAllowed is the reason set when the Release references a ReleaseStrategy in a
+	// namespace it's not allowed to use strategies from
+	ReleaseReasonReleaseStrategyNotAllowed ReleaseReason = "ReleaseStrategyNotAllowed"
+
+	// ReleaseReasonResultsOutputConflict is the reason set when the resource declared in the Release spec.resultsOutput
+	// already exists and isn't owned by the Release, so the results can't be written to it
+	ReleaseReasonResultsOutputConflict ReleaseReason = "ResultsOutputConflict"
+
+	// ReleaseReasonResumed is the reason set when the reconciliation of a paused Release is resumed
+	ReleaseReasonResumed ReleaseReason = "Resumed"
+
 	// ReleaseReasonRunning is the reason set when the release PipelineRun starts running
 	ReleaseReasonRunning ReleaseReason = "Running"
 
 	// ReleaseReasonSucceeded is the reason set when the release PipelineRun has succeeded
 	ReleaseReasonSucceeded ReleaseReason = "Succeeded"
+
+	// ReleaseReasonValidated is the reason set when the resources needed to trigger the release PipelineRun were
+	// resolved
+	ReleaseReasonValidated ReleaseReason = "Validated"
+
+	// ReleaseReasonWaitingForApproval is the reason set when the Release is waiting to be approved by one of its
+	// approvers
+	ReleaseReasonWaitingForApproval ReleaseReason = "WaitingForApproval"
+
+	// ReleaseReasonWaitingForConcurrencySlot is the reason set when the Release is waiting for other release
+	// PipelineRuns in the target namespace to finish
+	ReleaseReasonWaitingForConcurrencySlot ReleaseReason = "WaitingForConcurrencySlot"
+
+	// ReleaseReasonWaitingForDependency is the reason set when the Release is waiting for the Releases it depends on
+	// to succeed
+	ReleaseReasonWaitingForDependency ReleaseReason = "WaitingForDependency"
+
+	// ReleaseReasonWaitingForReleasePlanAdmission is the reason set when the ReleasePlan or ReleasePlanAdmission
+	// referenced by the Release doesn't exist yet
+	ReleaseReasonWaitingForReleasePlanAdmission ReleaseReason = "WaitingForReleasePlanAdmission"
+
+	// ReleaseReasonWorkspaceStorageBound is the reason set when the PersistentVolumeClaims backing the release
+	// PipelineRun workspaces are bound
+	ReleaseReasonWorkspaceStorageBound ReleaseReason = "WorkspaceStorageBound"
+
+	// ReleaseReasonWorkspaceStoragePending is the reason set when a PersistentVolumeClaim backing the release
+	// PipelineRun workspaces is waiting to be bound
+	ReleaseReasonWorkspaceStoragePending ReleaseReason = "WorkspaceStoragePending"
 )
 
 func (rr ReleaseReason) String() string {
@@ -69,13 +359,64 @@ func (rr ReleaseReason) String() string {
 }
 
 const (
+	// ApprovedByAnnotation is the annotation name set in Releases declaring approvers to record who approved them. Its
+	// value has to be the username of one of the approvers, and it can only be set by that user
+	ApprovedByAnnotation = "release.appstudio.openshift.io/approved-by"
+
+	// ApplicationLabel is the label name that can be set in Releases to declare the application they release. If set,
+	// it has to match the application of the ReleasePlan
+	ApplicationLabel = "appstudio.openshift.io/application"
+
 	// AutoReleaseLabel is the label name for the auto-release setting
 	AutoReleaseLabel = "release.appstudio.openshift.io/auto-release"
+
+	// DryRunAnnotation is the annotation name that can be set to true in Releases to render their release PipelineRun
+	// in their status instead of creating it
+	DryRunAnnotation = "release.appstudio.openshift.io/dry-run"
+
+	// OutcomeLabel is the label name set in finished Releases to expose their outcome
+	OutcomeLabel = "release.appstudio.openshift.io/outcome"
+
+	// OverrideTargetLabel is the label name required in Releases to allow them to override their target
+	OverrideTargetLabel = "release.appstudio.openshift.io/override-target"
+
+	// PauseAnnotation is the annotation name that can be set to true in Releases to pause their reconciliation
+	PauseAnnotation = "release.appstudio.openshift.io/pause"
+
+	// SummaryAnnotation is the annotation name set in finished Releases to expose a stable summary of their outcome
+	SummaryAnnotation = "release.appstudio.openshift.io/summary"
+
+	// CompletionEventOutcomeAnnotation is the annotation set in the event recorded once a Release finishes to expose
+	// its outcome. Its value is the same as the outcome label
+	CompletionEventOutcomeAnnotation = "release.appstudio.openshift.io/outcome"
+
+	// CompletionEventDurationAnnotation is the annotation set in the event recorded once a Release finishes to expose
+	// the duration of its release PipelineRun in seconds
+	CompletionEventDurationAnnotation = "release.appstudio.openshift.io/duration-seconds"
+
+	// CompletionEventDigestAnnotation is the annotation set in the event recorded once a Release finishes to expose
+	// the digest of the released image, if reported by the release PipelineRun
+	CompletionEventDigestAnnotation = "release.appstudio.openshift.io/digest"
+
+	// CompletionEventPipelineRunAnnotation is the annotation set in the event recorded once a Release finishes to
+	// expose the namespaced name of its release PipelineRun
+	CompletionEventPipelineRunAnnotation = "release.appstudio.openshift.io/release-pipelinerun"
+
+	// CompletionEventTargetAnnotation is the annotation set in the event recorded once a Release finishes to expose
+	// the namespace where it was executed
+	CompletionEventTargetAnnotation = "release.appstudio.openshift.io/target"
+
+	// OutcomeLabelSucceeded is the value of the outcome label for succeeded Releases
+	OutcomeLabelSucceeded = "succeeded"
+
+	// OutcomeLabelFailed is the value of the outcome label for failed Releases
+	OutcomeLabelFailed = "failed"
 )
 
 // ReleaseStatus defines the observed state of Release.
 type ReleaseStatus struct {
-	// StartTime is the time when the Release PipelineRun was created and set to run
+	// StartTime is the time when the Release PipelineRun started running. Until the PipelineRun reports its start
+	// time, it is the time when the PipelineRun was created
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
@@ -106,15 +447,120 @@ type ReleaseStatus struct {
 	// +optional
 	ReleasePipelineRun string `json:"releasePipelineRun,omitempty"`
 
+	// PipelineRunRef is a typed reference to the release PipelineRun executed as part of this release
+	// +optional
+	PipelineRunRef *corev1.ObjectReference `json:"pipelineRunRef,omitempty"`
+
+	// Pipeline contains the reference of the pipeline the release PipelineRun was created with
+	// +optional
+	Pipeline string `json:"pipeline,omitempty"`
+
 	// ReleaseStrategy contains the namespaced name of the ReleaseStrategy used for this release
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	ReleaseStrategy string `json:"releaseStrategy,omitempty"`
 
+	// Application is the name of the application released, as set in the release PipelineRun
+	// +optional
+	Application string `json:"application,omitempty"`
+
 	// Target references where this release is intended to be released to
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	Target string `json:"target,omitempty"`
+
+	// TargetOverridden indicates whether the target was set using the Release spec.overrideTarget field
+	// +optional
+	TargetOverridden bool `json:"targetOverridden,omitempty"`
+
+	// Notified indicates whether the notification of the outcome of this release has been dispatched to the webhooks
+	// of its ReleaseStrategy and to its notification channels
+	// +optional
+	Notified bool `json:"notified,omitempty"`
+
+	// Params contains the ReleaseStrategy params resolved for this release
+	// +optional
+	Params []Params `json:"params,omitempty"`
+
+	// SourceAnnotations contains a copy of the Release annotations selected for traceability, taken when the release
+	// PipelineRun was created
+	// +optional
+	SourceAnnotations map[string]string `json:"sourceAnnotations,omitempty"`
+
+	// ParamsDiff contains the changes in the resolved params compared to the most recent prior successful release
+	// using the same ReleasePlan and ReleaseStrategy
+	// +optional
+	ParamsDiff []ParamDiff `json:"paramsDiff,omitempty"`
+
+	// ReasonHistory contains the most recent transitions of the reason of the release PipelineRun Succeeded condition,
+	// oldest first. Consecutive identical reasons are only recorded once
+	// +optional
+	ReasonHistory []ReasonTransition `json:"reasonHistory,omitempty"`
+
+	// FailureLog contains an excerpt of the logs of the failed step of the release PipelineRun
+	// +optional
+	FailureLog string `json:"failureLog,omitempty"`
+
+	// CollectorsPipelineRun contains the namespaced name of the PipelineRun running the collectors of this release
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	CollectorsPipelineRun string `json:"collectorsPipelineRun,omitempty"`
+
+	// CollectorResults contains the results produced by the collectors of this release
+	// +optional
+	CollectorResults []CollectorResult `json:"collectorResults,omitempty"`
+
+	// RenderedPipelineRun contains the YAML representation of the release PipelineRun rendered for a Release in
+	// dry-run mode
+	// +optional
+	RenderedPipelineRun string `json:"renderedPipelineRun,omitempty"`
+
+	// Results contains the results produced by the release PipelineRun once it succeeded. They are not set when the
+	// results are written to a Secret, as they are considered sensitive
+	// +optional
+	Results []ReleaseResult `json:"results,omitempty"`
+}
+
+// CollectorResult holds the results produced by a collector
+type CollectorResult struct {
+	// Name is the name of the collector
+	Name string `json:"name"`
+
+	// Results contains the results of the collector task, indexed by their names
+	// +optional
+	Results map[string]string `json:"results,omitempty"`
+}
+
+// ReleaseResult holds a result produced by the release PipelineRun
+type ReleaseResult struct {
+	// Name is the name of the result
+	Name string `json:"name"`
+
+	// Value is the value of the result. Results that are not strings are stored in json format
+	Value string `json:"value"`
+}
+
+// ReasonTransition describes a change of the reason of the release PipelineRun Succeeded condition
+type ReasonTransition struct {
+	// Reason is the reason the release PipelineRun reported
+	Reason string `json:"reason"`
+
+	// Time is when the release PipelineRun transitioned to the reason
+	Time metav1.Time `json:"time"`
+}
+
+// ParamDiff describes how a param changed between two releases
+type ParamDiff struct {
+	// Name is the name of the param
+	Name string `json:"name"`
+
+	// Previous is the value of the param in the previous release. It's empty if the param was added
+	// +optional
+	Previous string `json:"previous,omitempty"`
+
+	// Current is the value of the param in this release. It's empty if the param was removed
+	// +optional
+	Current string `json:"current,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -148,6 +594,32 @@ func (r *Release) HasSucceeded() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, releaseConditionType)
 }
 
+// IsApproved checks whether the Release doesn't require an approval or it was approved by one of its approvers.
+func (r *Release) IsApproved() bool {
+	if len(r.Spec.Approvers) == 0 {
+		return true
+	}
+
+	return r.IsApprover(r.GetAnnotations()[ApprovedByAnnotation])
+}
+
+// IsApprover checks whether the given username is one of the approvers of the Release.
+func (r *Release) IsApprover(username string) bool {
+	for _, approver := range r.Spec.Approvers {
+		if username != "" && approver == username {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCollectingMetadata checks whether the collectors of the Release are still running.
+func (r *Release) IsCollectingMetadata() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, metadataCollectedConditionType)
+	return condition != nil && condition.Status == metav1.ConditionUnknown
+}
+
 // IsDeployed checks whether the Release has been successfully deployed via GitOps.
 func (r *Release) IsDeployed() bool {
 	condition := meta.FindStatusCondition(r.Status.Conditions, applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed)
@@ -165,6 +637,66 @@ func (r *Release) IsDone() bool {
 	return condition != nil && condition.Status != metav1.ConditionUnknown
 }
 
+// IsDryRun checks whether the Release has the dry-run annotation set to true, so its release PipelineRun is only
+// rendered.
+func (r *Release) IsDryRun() bool {
+	dryRun, err := strconv.ParseBool(r.GetAnnotations()[DryRunAnnotation])
+	return err == nil && dryRun
+}
+
+// IsMatchedViaFallback checks whether the ReleasePlanAdmission of the Release was found by matching its application
+// alone, as no ReleasePlanAdmission matched the ReleasePlan target.
+func (r *Release) IsMatchedViaFallback() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, matchedViaFallbackConditionType)
+}
+
+// IsMetadataCollectionDone checks whether the collectors of the Release finished running, no matter the outcome.
+func (r *Release) IsMetadataCollectionDone() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, metadataCollectedConditionType)
+	return condition != nil && condition.Status != metav1.ConditionUnknown
+}
+
+// IsPaused checks whether the reconciliation of the Release is paused.
+func (r *Release) IsPaused() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, pausedConditionType)
+}
+
+// IsPipelineRunTampered checks whether the release PipelineRun was modified to reference a different pipeline.
+func (r *Release) IsPipelineRunTampered() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, pipelineRunTamperedConditionType)
+}
+
+// IsProvenanceChecked checks whether the provenance of the Release has already been verified or reported as missing.
+func (r *Release) IsProvenanceChecked() bool {
+	return meta.FindStatusCondition(r.Status.Conditions, provenanceConditionType) != nil
+}
+
+// IsProvenanceVerified checks whether the provenance of the Release has been verified.
+func (r *Release) IsProvenanceVerified() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, provenanceConditionType)
+}
+
+// IsValidated checks whether the resources needed to trigger the release PipelineRun of the Release were resolved.
+func (r *Release) IsValidated() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, validatedConditionType)
+}
+
+// IsWorkspaceStorageBound checks whether the PersistentVolumeClaims backing the release PipelineRun workspaces are
+// bound.
+func (r *Release) IsWorkspaceStorageBound() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, workspaceStorageBindingConditionType)
+}
+
+// MarkCollectingMetadata changes the MetadataCollected condition to Unknown. This method has no effect if the
+// collectors already finished running.
+func (r *Release) MarkCollectingMetadata() {
+	if r.IsMetadataCollectionDone() {
+		return
+	}
+
+	r.setStatusCondition(metadataCollectedConditionType, metav1.ConditionUnknown, ReleaseReasonCollectorsRunning)
+}
+
 // MarkDeployed registers the deployment completion time and sets the AllComponentsDeployed status in the
 // Release to True with the provided reason and message.
 func (r *Release) MarkDeployed(reason, message string) {
@@ -196,17 +728,20 @@ func (r *Release) MarkDeploying(status metav1.ConditionStatus, reason, message s
 }
 
 // MarkFailed registers the completion time and changes the Succeeded condition to False with
-// the provided reason and message.
+// the provided reason and message. A completion time that is already set is kept.
 func (r *Release) MarkFailed(reason ReleaseReason, message string) {
 	if r.IsDone() && r.Status.CompletionTime != nil {
 		return
 	}
 
-	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	if r.Status.CompletionTime == nil {
+		r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	}
 	r.setStatusConditionWithMessage(releaseConditionType, metav1.ConditionFalse, reason, message)
 
 	go metrics.RegisterCompletedRelease(reason.String(), r.Status.ReleaseStrategy, r.Status.Target,
 		r.Status.StartTime, r.Status.CompletionTime, false)
+	go metrics.RegisterReleaseOutcome(r.Namespace, r.Status.Application, r.CreationTimestamp, r.Status.CompletionTime, false)
 }
 
 // MarkInvalid changes the Succeeded condition to False with the provided reason and message.
@@ -218,6 +753,84 @@ func (r *Release) MarkInvalid(reason ReleaseReason, message string) {
 	r.setStatusConditionWithMessage(releaseConditionType, metav1.ConditionFalse, reason, message)
 
 	go metrics.RegisterInvalidRelease(reason.String())
+	go metrics.RegisterReleaseOutcome(r.Namespace, r.Status.Application, r.CreationTimestamp, nil, false)
+}
+
+// MarkMatchedViaFallback changes the MatchedViaFallback condition to True with the provided message.
+func (r *Release) MarkMatchedViaFallback(message string) {
+	r.setStatusConditionWithMessage(matchedViaFallbackConditionType, metav1.ConditionTrue, ReleaseReasonTargetNotFound, message)
+}
+
+// MarkMetadataCollected changes the MetadataCollected condition to True. This method has no effect if the collectors
+// are not running.
+func (r *Release) MarkMetadataCollected() {
+	if !r.IsCollectingMetadata() {
+		return
+	}
+
+	r.setStatusCondition(metadataCollectedConditionType, metav1.ConditionTrue, ReleaseReasonCollectorsSucceeded)
+}
+
+// MarkMetadataCollectionFailed changes the MetadataCollected condition to False with the provided message. This
+// method has no effect if the collectors are not running.
+func (r *Release) MarkMetadataCollectionFailed(message string) {
+	if !r.IsCollectingMetadata() {
+		return
+	}
+
+	r.setStatusConditionWithMessage(metadataCollectedConditionType, metav1.ConditionFalse,
+		ReleaseReasonCollectorsFailed, message)
+}
+
+// MarkPaused changes the Paused condition to True.
+func (r *Release) MarkPaused() {
+	r.setStatusConditionWithMessage(pausedConditionType, metav1.ConditionTrue, ReleaseReasonPaused,
+		"the reconciliation is paused by the "+PauseAnnotation+" annotation")
+}
+
+// MarkPipelineRunTampered changes the PipelineRunTampered condition to True with the provided message.
+func (r *Release) MarkPipelineRunTampered(message string) {
+	r.setStatusConditionWithMessage(pipelineRunTamperedConditionType, metav1.ConditionTrue,
+		ReleaseReasonPipelineRefChanged, message)
+}
+
+// MarkProgressing changes the Succeeded condition to Unknown with the provided reason and message. This method has
+// no effect if the Release hasn't started or has already finished.
+func (r *Release) MarkProgressing(reason ReleaseReason, message string) {
+	if !r.HasStarted() || r.IsDone() {
+		return
+	}
+
+	r.setStatusConditionWithMessage(releaseConditionType, metav1.ConditionUnknown, reason, message)
+}
+
+// MarkProvenanceMissing changes the ProvenanceVerified condition to False with the provided message. This method has
+// no effect if the Release hasn't succeeded.
+func (r *Release) MarkProvenanceMissing(message string) {
+	if !r.HasSucceeded() {
+		return
+	}
+
+	r.setStatusConditionWithMessage(provenanceConditionType, metav1.ConditionFalse, ReleaseReasonProvenanceMissing, message)
+}
+
+// MarkProvenanceVerified changes the ProvenanceVerified condition to True. This method has no effect if the Release
+// hasn't succeeded.
+func (r *Release) MarkProvenanceVerified() {
+	if !r.HasSucceeded() {
+		return
+	}
+
+	r.setStatusCondition(provenanceConditionType, metav1.ConditionTrue, ReleaseReasonProvenanceVerified)
+}
+
+// MarkResumed changes the Paused condition to False. This method has no effect if the Release isn't paused.
+func (r *Release) MarkResumed() {
+	if !r.IsPaused() {
+		return
+	}
+
+	r.setStatusCondition(pausedConditionType, metav1.ConditionFalse, ReleaseReasonResumed)
 }
 
 // MarkRunning registers the start time and changes the Succeeded condition to Unknown.
@@ -234,15 +847,65 @@ func (r *Release) MarkRunning() {
 
 // MarkSucceeded registers the completion time and changes the Succeeded condition to True.
 func (r *Release) MarkSucceeded() {
+	r.MarkSucceededWithMessage("")
+}
+
+// MarkSucceededWithMessage registers the completion time and changes the Succeeded condition to True with the
+// provided message. A completion time that is already set is kept.
+func (r *Release) MarkSucceededWithMessage(message string) {
 	if !r.HasStarted() || (r.IsDone() && r.Status.CompletionTime != nil) {
 		return
 	}
 
-	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
-	r.setStatusCondition(releaseConditionType, metav1.ConditionTrue, ReleaseReasonSucceeded)
+	if r.Status.CompletionTime == nil {
+		r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	}
+	r.setStatusConditionWithMessage(releaseConditionType, metav1.ConditionTrue, ReleaseReasonSucceeded, message)
 
 	go metrics.RegisterCompletedRelease(ReleaseReasonSucceeded.String(), r.Status.ReleaseStrategy, r.Status.Target,
 		r.Status.StartTime, r.Status.CompletionTime, true)
+	go metrics.RegisterReleaseOutcome(r.Namespace, r.Status.Application, r.CreationTimestamp, r.Status.CompletionTime, true)
+	go metrics.RegisterReleaseLeadTime(r.Status.Application, r.CreationTimestamp, r.Status.CompletionTime)
+}
+
+// MarkValidated changes the Validated condition to True. This method has no effect if the Release already finished.
+func (r *Release) MarkValidated() {
+	if r.IsDone() {
+		return
+	}
+
+	r.setStatusCondition(validatedConditionType, metav1.ConditionTrue, ReleaseReasonValidated)
+}
+
+// MarkWaiting changes the Succeeded condition to Unknown with the provided reason and message. This method has no
+// effect if the Release already started or finished.
+func (r *Release) MarkWaiting(reason ReleaseReason, message string) {
+	if r.HasStarted() || r.IsDone() {
+		return
+	}
+
+	r.setStatusConditionWithMessage(releaseConditionType, metav1.ConditionUnknown, reason, message)
+}
+
+// MarkWorkspaceStorageBound changes the WorkspaceStorageBinding condition to True. This method has no effect if the
+// Release hasn't started or already finished.
+func (r *Release) MarkWorkspaceStorageBound() {
+	if !r.HasStarted() || r.IsDone() {
+		return
+	}
+
+	r.setStatusCondition(workspaceStorageBindingConditionType, metav1.ConditionTrue, ReleaseReasonWorkspaceStorageBound)
+}
+
+// MarkWorkspaceStoragePending changes the WorkspaceStorageBinding condition to False with the provided message. This
+// method has no effect if the Release hasn't started or already finished.
+func (r *Release) MarkWorkspaceStoragePending(message string) {
+	if !r.HasStarted() || r.IsDone() {
+		return
+	}
+
+	r.setStatusConditionWithMessage(workspaceStorageBindingConditionType, metav1.ConditionFalse,
+		ReleaseReasonWorkspaceStoragePending, message)
 }
 
 // SetCondition creates a new condition with the given conditionType, status and reason. Then, it sets this new condition,
@@ -91,6 +91,55 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	Context("When IsApproved method is called", func() {
+		It("should return true when the Release has no approvers", func() {
+			Expect(r.IsApproved()).To(BeTrue())
+		})
+
+		It("should return false when the Release has approvers but no approval", func() {
+			r.Spec.Approvers = []string{"alice"}
+			Expect(r.IsApproved()).To(BeFalse())
+		})
+
+		It("should return false when the Release was approved by someone who is not an approver", func() {
+			r.Spec.Approvers = []string{"alice"}
+			r.SetAnnotations(map[string]string{ApprovedByAnnotation: "bob"})
+			Expect(r.IsApproved()).To(BeFalse())
+		})
+
+		It("should return true when the Release was approved by one of its approvers", func() {
+			r.Spec.Approvers = []string{"alice", "bob"}
+			r.SetAnnotations(map[string]string{ApprovedByAnnotation: "bob"})
+			Expect(r.IsApproved()).To(BeTrue())
+		})
+	})
+
+	Context("When IsApprover method is called", func() {
+		It("should return whether the username is one of the approvers", func() {
+			r.Spec.Approvers = []string{"alice"}
+			Expect(r.IsApprover("alice")).To(BeTrue())
+			Expect(r.IsApprover("bob")).To(BeFalse())
+			Expect(r.IsApprover("")).To(BeFalse())
+		})
+	})
+
+	Context("When IsCollectingMetadata method is called", func() {
+		It("should return false when the metadata collection condition is missing", func() {
+			Expect(r.IsCollectingMetadata()).To(BeFalse())
+		})
+
+		It("should return true when the metadata collection condition is Unknown", func() {
+			r.MarkCollectingMetadata()
+			Expect(r.IsCollectingMetadata()).To(BeTrue())
+		})
+
+		It("should return false when the collectors finished running", func() {
+			r.MarkCollectingMetadata()
+			r.MarkMetadataCollected()
+			Expect(r.IsCollectingMetadata()).To(BeFalse())
+		})
+	})
+
 	Context("When IsDeployed method is called", func() {
 		It("should return true when AllComponentsDeployed condition status is True", func() {
 			r.Status.Conditions[0] = metav1.Condition{
@@ -156,6 +205,131 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	Context("When IsDryRun method is called", func() {
+		It("should return false when the dry-run annotation is missing", func() {
+			Expect(r.IsDryRun()).To(BeFalse())
+		})
+
+		It("should return true when the dry-run annotation is set to true", func() {
+			r.SetAnnotations(map[string]string{DryRunAnnotation: "true"})
+			Expect(r.IsDryRun()).To(BeTrue())
+		})
+
+		It("should return false when the dry-run annotation is not a boolean", func() {
+			r.SetAnnotations(map[string]string{DryRunAnnotation: "yes please"})
+			Expect(r.IsDryRun()).To(BeFalse())
+		})
+	})
+
+	Context("When IsMatchedViaFallback method is called", func() {
+		It("should return false when the fallback condition is missing", func() {
+			Expect(r.IsMatchedViaFallback()).To(BeFalse())
+		})
+
+		It("should return true when the fallback condition is True", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   matchedViaFallbackConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			Expect(r.IsMatchedViaFallback()).To(BeTrue())
+		})
+	})
+
+	Context("When IsMetadataCollectionDone method is called", func() {
+		It("should return false when the metadata collection condition is missing", func() {
+			Expect(r.IsMetadataCollectionDone()).To(BeFalse())
+		})
+
+		It("should return false when the collectors are running", func() {
+			r.MarkCollectingMetadata()
+			Expect(r.IsMetadataCollectionDone()).To(BeFalse())
+		})
+
+		It("should return true when the collectors failed", func() {
+			r.MarkCollectingMetadata()
+			r.MarkMetadataCollectionFailed("")
+			Expect(r.IsMetadataCollectionDone()).To(BeTrue())
+		})
+	})
+
+	Context("When IsPaused method is called", func() {
+		It("should return false when the paused condition is missing", func() {
+			Expect(r.IsPaused()).To(BeFalse())
+		})
+
+		It("should return true when the paused condition is True", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   pausedConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			Expect(r.IsPaused()).To(BeTrue())
+		})
+	})
+
+	Context("When IsPipelineRunTampered method is called", func() {
+		It("should return false when the tampering condition is missing", func() {
+			Expect(r.IsPipelineRunTampered()).To(BeFalse())
+		})
+
+		It("should return true when the tampering condition is True", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   pipelineRunTamperedConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			Expect(r.IsPipelineRunTampered()).To(BeTrue())
+		})
+	})
+
+	Context("When IsProvenanceChecked method is called", func() {
+		It("should return false when the provenance condition is not set", func() {
+			Expect(r.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should return true when the provenance condition is set", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   provenanceConditionType,
+				Status: metav1.ConditionFalse,
+			}
+			Expect(r.IsProvenanceChecked()).To(BeTrue())
+		})
+	})
+
+	Context("When IsProvenanceVerified method is called", func() {
+		It("should return false when the provenance condition is False", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   provenanceConditionType,
+				Status: metav1.ConditionFalse,
+			}
+			Expect(r.IsProvenanceVerified()).To(BeFalse())
+		})
+
+		It("should return true when the provenance condition is True", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   provenanceConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			Expect(r.IsProvenanceVerified()).To(BeTrue())
+		})
+	})
+
+	Context("When MarkCollectingMetadata method is called", func() {
+		It("should register the collectors as running", func() {
+			r.MarkCollectingMetadata()
+			Expect(meta.FindStatusCondition(r.Status.Conditions, metadataCollectedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Status": Equal(metav1.ConditionUnknown),
+					"Reason": Equal(ReleaseReasonCollectorsRunning.String()),
+				})))
+		})
+
+		It("should do nothing when the collectors finished running", func() {
+			r.MarkCollectingMetadata()
+			r.MarkMetadataCollected()
+			r.MarkCollectingMetadata()
+			Expect(r.IsMetadataCollectionDone()).To(BeTrue())
+		})
+	})
+
 	Context("When MarkDeployed method is called", func() {
 		It("should do nothing if the Release is already deployed", func() {
 			r.Status.Conditions[0] = metav1.Condition{
@@ -261,6 +435,15 @@ var _ = Describe("Release type", func() {
 				"Message": Equal("what does the fox say"),
 			}))
 		})
+
+		It("should keep the completion time already set when the Release is not complete", func() {
+			completionTime := &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			r.Status.CompletionTime = completionTime.DeepCopy()
+			r.MarkFailed(ReleaseReasonPipelineFailed, "")
+			Expect(r.HasSucceeded()).To(BeFalse())
+			Expect(r.IsDone()).To(BeTrue())
+			Expect(r.Status.CompletionTime).To(Equal(completionTime))
+		})
 	})
 
 	Context("When MarkInvalid method is called", func() {
@@ -308,6 +491,165 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	Context("When MarkMatchedViaFallback method is called", func() {
+		It("should register the fallback match", func() {
+			r.MarkMatchedViaFallback("matched")
+			Expect(r.IsMatchedViaFallback()).To(BeTrue())
+			Expect(meta.FindStatusCondition(r.Status.Conditions, matchedViaFallbackConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Reason":  Equal(ReleaseReasonTargetNotFound.String()),
+					"Message": Equal("matched"),
+				})))
+		})
+	})
+
+	Context("When MarkMetadataCollected method is called", func() {
+		It("should do nothing when the collectors are not running", func() {
+			r.MarkMetadataCollected()
+			Expect(r.IsMetadataCollectionDone()).To(BeFalse())
+		})
+
+		It("should register the collectors as succeeded", func() {
+			r.MarkCollectingMetadata()
+			r.MarkMetadataCollected()
+			Expect(meta.FindStatusCondition(r.Status.Conditions, metadataCollectedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Status": Equal(metav1.ConditionTrue),
+					"Reason": Equal(ReleaseReasonCollectorsSucceeded.String()),
+				})))
+		})
+	})
+
+	Context("When MarkMetadataCollectionFailed method is called", func() {
+		It("should do nothing when the collectors are not running", func() {
+			r.MarkMetadataCollectionFailed("")
+			Expect(r.IsMetadataCollectionDone()).To(BeFalse())
+		})
+
+		It("should register the collectors as failed", func() {
+			r.MarkCollectingMetadata()
+			r.MarkMetadataCollectionFailed("collector failed")
+			Expect(meta.FindStatusCondition(r.Status.Conditions, metadataCollectedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Status":  Equal(metav1.ConditionFalse),
+					"Reason":  Equal(ReleaseReasonCollectorsFailed.String()),
+					"Message": Equal("collector failed"),
+				})))
+		})
+	})
+
+	Context("When MarkPaused method is called", func() {
+		It("should register the pause", func() {
+			r.MarkPaused()
+			Expect(r.IsPaused()).To(BeTrue())
+			Expect(meta.FindStatusCondition(r.Status.Conditions, pausedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Reason":  Equal(ReleaseReasonPaused.String()),
+					"Message": ContainSubstring(PauseAnnotation),
+				})))
+		})
+	})
+
+	Context("When MarkPipelineRunTampered method is called", func() {
+		It("should register the tampering", func() {
+			r.MarkPipelineRunTampered("tampered")
+			Expect(r.IsPipelineRunTampered()).To(BeTrue())
+			Expect(meta.FindStatusCondition(r.Status.Conditions, pipelineRunTamperedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Reason":  Equal(ReleaseReasonPipelineRefChanged.String()),
+					"Message": Equal("tampered"),
+				})))
+		})
+	})
+
+	Context("When MarkProgressing method is called", func() {
+		It("should do nothing when the Release has not started", func() {
+			r.Status.StartTime = nil
+			r.MarkProgressing(ReleaseReasonPipelinePaused, "paused")
+			Expect(len(r.Status.Conditions)).To(Equal(1))
+			Expect(r.Status.Conditions[0].Reason).To(BeEmpty())
+		})
+
+		It("should do nothing when the Release has finished", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   releaseConditionType,
+				Status: metav1.ConditionTrue,
+				Reason: ReleaseReasonSucceeded.String(),
+			}
+			r.MarkProgressing(ReleaseReasonPipelinePaused, "paused")
+			Expect(len(r.Status.Conditions)).To(Equal(1))
+			Expect(r.Status.Conditions[0].Reason).To(Equal(ReleaseReasonSucceeded.String()))
+		})
+
+		It("should register the provided reason when the Release is running", func() {
+			r.MarkProgressing(ReleaseReasonPipelinePaused, "paused")
+			Expect(len(r.Status.Conditions)).To(Equal(2))
+			Expect(r.Status.Conditions[1]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionUnknown),
+				"Type":    Equal(releaseConditionType),
+				"Reason":  Equal(ReleaseReasonPipelinePaused.String()),
+				"Message": Equal("paused"),
+			}))
+		})
+	})
+
+	Context("When MarkProvenanceMissing method is called", func() {
+		It("should do nothing when the Release has not succeeded", func() {
+			r.MarkProvenanceMissing("missing")
+			Expect(r.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should register the missing provenance when the Release has succeeded", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   releaseConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			r.MarkProvenanceMissing("missing")
+			Expect(len(r.Status.Conditions)).To(Equal(2))
+			Expect(r.Status.Conditions[1]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionFalse),
+				"Type":    Equal(provenanceConditionType),
+				"Reason":  Equal(ReleaseReasonProvenanceMissing.String()),
+				"Message": Equal("missing"),
+			}))
+		})
+	})
+
+	Context("When MarkProvenanceVerified method is called", func() {
+		It("should do nothing when the Release has not succeeded", func() {
+			r.MarkProvenanceVerified()
+			Expect(r.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should register the verified provenance when the Release has succeeded", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   releaseConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			r.MarkProvenanceVerified()
+			Expect(r.IsProvenanceVerified()).To(BeTrue())
+			Expect(r.Status.Conditions[1].Reason).To(Equal(ReleaseReasonProvenanceVerified.String()))
+		})
+	})
+
+	Context("When MarkResumed method is called", func() {
+		It("should do nothing when the Release isn't paused", func() {
+			r.MarkResumed()
+			Expect(meta.FindStatusCondition(r.Status.Conditions, pausedConditionType)).To(BeNil())
+		})
+
+		It("should register the resume of a paused Release", func() {
+			r.MarkPaused()
+			r.MarkResumed()
+			Expect(r.IsPaused()).To(BeFalse())
+			Expect(meta.FindStatusCondition(r.Status.Conditions, pausedConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Status": Equal(metav1.ConditionFalse),
+					"Reason": Equal(ReleaseReasonResumed.String()),
+				})))
+		})
+	})
+
 	Context("When MarkRunning method is called", func() {
 		It("should do nothing when the Release is already running", func() {
 			r.Status.Conditions[0] = metav1.Condition{
@@ -328,6 +670,13 @@ var _ = Describe("Release type", func() {
 			}))
 		})
 
+		It("should keep the original start time when the Release is already running", func() {
+			startTime := r.Status.StartTime.DeepCopy()
+			r.MarkRunning()
+			r.MarkRunning()
+			Expect(r.Status.StartTime).To(Equal(startTime))
+		})
+
 		It("should register the Running status when the Release is not running", func() {
 			r.Status.StartTime = nil
 			r.Status.StartTime = &metav1.Time{
@@ -382,6 +731,15 @@ var _ = Describe("Release type", func() {
 			rr = nil
 		})
 
+		It("should keep the original completion time when the Release has already finished", func() {
+			r.Status.CompletionTime = nil
+			r.MarkSucceeded()
+			completionTime := r.Status.CompletionTime.DeepCopy()
+			r.MarkSucceeded()
+			r.MarkFailed(ReleaseReasonPipelineFailed, "")
+			Expect(r.Status.CompletionTime).To(Equal(completionTime))
+		})
+
 		It("register the Succeeded status when the Release is completed", func() {
 			r.Status.Conditions[0] = metav1.Condition{
 				Type:    "Fail",
@@ -406,6 +764,130 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	Context("When MarkSucceededWithMessage method is called", func() {
+		It("register the Succeeded status with the given message when the Release is completed", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   "Fail",
+				Status: metav1.ConditionUnknown,
+				Reason: ReleaseReasonValidationError.String(),
+			}
+			r.MarkSucceededWithMessage("Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1")
+			Expect(r.Status.CompletionTime).ToNot(BeNil())
+			Expect(len(r.Status.Conditions)).To(Equal(2))
+			Expect(r.Status.Conditions[1]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionTrue),
+				"Type":    Equal(releaseConditionType),
+				"Reason":  Equal(ReleaseReasonSucceeded.String()),
+				"Message": Equal("Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1"),
+			}))
+		})
+
+		It("should keep the completion time already set when the Release is not complete", func() {
+			completionTime := &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			r.Status.CompletionTime = completionTime.DeepCopy()
+			r.MarkSucceededWithMessage("")
+			Expect(r.HasSucceeded()).To(BeTrue())
+			Expect(r.Status.CompletionTime).To(Equal(completionTime))
+		})
+	})
+
+	Context("When MarkValidated method is called", func() {
+		It("should register the Validated condition", func() {
+			r.Status.Conditions = []metav1.Condition{}
+			r.Status.CompletionTime = nil
+			Expect(r.IsValidated()).To(BeFalse())
+			r.MarkValidated()
+			Expect(r.IsValidated()).To(BeTrue())
+			Expect(r.Status.Conditions).To(HaveLen(1))
+			Expect(r.Status.Conditions[0]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status": Equal(metav1.ConditionTrue),
+				"Type":   Equal(validatedConditionType),
+				"Reason": Equal(ReleaseReasonValidated.String()),
+			}))
+		})
+
+		It("should do nothing if the Release already finished", func() {
+			r.Status.Conditions = []metav1.Condition{}
+			r.MarkFailed(ReleaseReasonPipelineFailed, "")
+			r.MarkValidated()
+			Expect(r.IsValidated()).To(BeFalse())
+		})
+	})
+
+	Context("When MarkWaiting method is called", func() {
+		It("should register the waiting status when the Release has not started", func() {
+			r.Status.StartTime = nil
+			r.Status.CompletionTime = nil
+			r.MarkWaiting(ReleaseReasonWaitingForConcurrencySlot, "2 release PipelineRuns in flight")
+			Expect(r.Status.StartTime).To(BeNil())
+			Expect(len(r.Status.Conditions)).To(Equal(2))
+			Expect(r.Status.Conditions[1]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionUnknown),
+				"Type":    Equal(releaseConditionType),
+				"Reason":  Equal(ReleaseReasonWaitingForConcurrencySlot.String()),
+				"Message": Equal("2 release PipelineRuns in flight"),
+			}))
+		})
+
+		It("should do nothing when the Release has already started", func() {
+			r.MarkWaiting(ReleaseReasonWaitingForConcurrencySlot, "2 release PipelineRuns in flight")
+			Expect(len(r.Status.Conditions)).To(Equal(1))
+			Expect(r.Status.Conditions[0].Reason).To(BeEmpty())
+		})
+
+		It("should be cleared once the Release is marked as running", func() {
+			r.Status.StartTime = nil
+			r.Status.CompletionTime = nil
+			r.MarkWaiting(ReleaseReasonWaitingForConcurrencySlot, "2 release PipelineRuns in flight")
+			r.MarkRunning()
+			Expect(len(r.Status.Conditions)).To(Equal(2))
+			Expect(r.Status.Conditions[1]).To(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionUnknown),
+				"Type":    Equal(releaseConditionType),
+				"Reason":  Equal(ReleaseReasonRunning.String()),
+				"Message": Equal(""),
+			}))
+		})
+	})
+
+	Context("When MarkWorkspaceStorageBound method is called", func() {
+		It("should register the bound workspace storage when the Release is running", func() {
+			r.MarkWorkspaceStoragePending("pending")
+			r.MarkWorkspaceStorageBound()
+			Expect(r.IsWorkspaceStorageBound()).To(BeTrue())
+			condition := meta.FindStatusCondition(r.Status.Conditions, workspaceStorageBindingConditionType)
+			Expect(condition.Reason).To(Equal(ReleaseReasonWorkspaceStorageBound.String()))
+		})
+
+		It("should do nothing when the Release has not started", func() {
+			r.Status.StartTime = nil
+			r.MarkWorkspaceStorageBound()
+			Expect(meta.FindStatusCondition(r.Status.Conditions, workspaceStorageBindingConditionType)).To(BeNil())
+		})
+	})
+
+	Context("When MarkWorkspaceStoragePending method is called", func() {
+		It("should register the pending workspace storage when the Release is running", func() {
+			r.MarkWorkspaceStoragePending("PersistentVolumeClaim default/release-pvc is pending")
+			Expect(r.IsWorkspaceStorageBound()).To(BeFalse())
+			Expect(meta.FindStatusCondition(r.Status.Conditions, workspaceStorageBindingConditionType)).To(
+				PointTo(MatchFields(IgnoreMissing|IgnoreExtras, Fields{
+					"Status":  Equal(metav1.ConditionFalse),
+					"Reason":  Equal(ReleaseReasonWorkspaceStoragePending.String()),
+					"Message": Equal("PersistentVolumeClaim default/release-pvc is pending"),
+				})))
+		})
+
+		It("should do nothing when the Release is done", func() {
+			r.Status.Conditions[0] = metav1.Condition{
+				Type:   releaseConditionType,
+				Status: metav1.ConditionTrue,
+			}
+			r.MarkWorkspaceStoragePending("pending")
+			Expect(meta.FindStatusCondition(r.Status.Conditions, workspaceStorageBindingConditionType)).To(BeNil())
+		})
+	})
+
 	Context("When setStatusCondition method is called", func() {
 		It("should update condition with provided arguments, and empty message", func() {
 			args := conditionValues{
@@ -450,4 +932,35 @@ var _ = Describe("Release type", func() {
 			}))
 		})
 	})
+
+	Context("When a Release goes through its lifecycle", func() {
+		It("should only ever have a single Succeeded condition", func() {
+			release := &Release{}
+			succeededConditions := func() []metav1.Condition {
+				var conditions []metav1.Condition
+				for _, condition := range release.Status.Conditions {
+					if condition.Type == releaseConditionType {
+						conditions = append(conditions, condition)
+					}
+				}
+				return conditions
+			}
+
+			release.MarkWaiting(ReleaseReasonWaitingForConcurrencySlot, "")
+			Expect(succeededConditions()).To(HaveLen(1))
+			Expect(succeededConditions()[0].Status).To(Equal(metav1.ConditionUnknown))
+
+			release.MarkRunning()
+			Expect(succeededConditions()).To(HaveLen(1))
+			Expect(succeededConditions()[0].Reason).To(Equal(ReleaseReasonRunning.String()))
+
+			release.MarkSucceeded()
+			Expect(succeededConditions()).To(HaveLen(1))
+			Expect(succeededConditions()[0].Status).To(Equal(metav1.ConditionTrue))
+
+			release.MarkFailed(ReleaseReasonPipelineFailed, "")
+			Expect(succeededConditions()).To(HaveLen(1))
+			Expect(succeededConditions()[0].Reason).To(Equal(ReleaseReasonSucceeded.String()))
+		})
+	})
 })
@@ -17,38 +17,204 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
-	"k8s.io/apimachinery/pkg/runtime"
+	"net/http"
 	"reflect"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// reservedLabelDomains are the label key domains, and their subdomains, that can't be used in the Release spec.labels
+// as they're owned by the platform.
+var reservedLabelDomains = []string{
+	"appstudio.openshift.io",
+	"appstudio.redhat.com",
+	"k8s.io",
+	"kubernetes.io",
+	"tekton.dev",
+}
+
+// releaseApprovalWebhookPath is the path where the webhook validating the Release approvals is served
+const releaseApprovalWebhookPath = "/validate-appstudio-redhat-com-v1alpha1-release-approval"
+
 func (r *Release) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(releaseApprovalWebhookPath, &webhook.Admission{Handler: &releaseApprovalValidator{}})
+
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
 }
 
+//+kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-release,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases,verbs=create;update,versions=v1alpha1,name=mrelease.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Release{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type. The labels set in the Release
+// spec are added to its metadata, so they are restored if they are removed from the Release.
+func (r *Release) Default() {
+	if len(r.Spec.Labels) == 0 {
+		return
+	}
+
+	if r.Labels == nil {
+		r.Labels = make(map[string]string, len(r.Spec.Labels))
+	}
+
+	for key, value := range r.Spec.Labels {
+		r.Labels[key] = value
+	}
+}
+
 //+kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-release,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases,verbs=create;update,versions=v1alpha1,name=vrelease.kb.io,admissionReviewVersions=v1
 
 var _ webhook.Validator = &Release{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Release) ValidateCreate() error {
-	return nil
+	if err := r.validateLabelsToResults(); err != nil {
+		return err
+	}
+
+	return r.validateLabels()
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type. The Release spec can only
+// be updated until its release PipelineRun is triggered, as the status would no longer describe the spec otherwise.
+// The approvers can't be updated at all, so users can't add themselves to them before approving the Release. The
+// labels are validated as on creation, as they are added to the Release metadata on every update.
 func (r *Release) ValidateUpdate(old runtime.Object) error {
-	if !reflect.DeepEqual(r.Spec, old.(*Release).Spec) {
-		return fmt.Errorf("release resources spec cannot be updated")
+	oldRelease := old.(*Release)
+	if !reflect.DeepEqual(r.Spec.Approvers, oldRelease.Spec.Approvers) {
+		return field.Forbidden(field.NewPath("spec", "approvers"),
+			"release approvers cannot be updated once the release has been created")
 	}
 
-	return nil
+	if oldRelease.HasStarted() && !reflect.DeepEqual(r.Spec, oldRelease.Spec) {
+		return field.Forbidden(field.NewPath("spec"),
+			"release resources spec cannot be updated once the release PipelineRun has been triggered")
+	}
+
+	if err := r.validateLabelsToResults(); err != nil {
+		return err
+	}
+
+	return r.validateLabels()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *Release) ValidateDelete() error {
 	return nil
 }
+
+//+kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-release-approval,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releases,verbs=create;update,versions=v1alpha1,name=vreleaseapproval.kb.io,admissionReviewVersions=v1
+
+// releaseApprovalValidator is an admission handler ensuring the approved-by annotation of a Release can only be set
+// by the approver it names. Unlike webhook.Validator, an admission handler has access to the user making the request.
+type releaseApprovalValidator struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &releaseApprovalValidator{}
+
+// InjectDecoder implements admission.DecoderInjector so the webhook server provides the decoder used by the handler.
+func (v *releaseApprovalValidator) InjectDecoder(decoder *admission.Decoder) error {
+	v.decoder = decoder
+	return nil
+}
+
+// Handle denies the requests setting or changing the approved-by annotation of a Release unless the authenticated
+// user is the one named in the annotation and is one of the Release approvers. Releases can't be approved when they
+// are created, as the approvers would be set in the same request. On updates, the approvers are taken from the Release
+// before the update, so users can't add themselves to the approvers in the request approving it.
+func (v *releaseApprovalValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	release := &Release{}
+	if err := v.decoder.Decode(req, release); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	approver := release.GetAnnotations()[ApprovedByAnnotation]
+	if req.Operation == admissionv1.Create {
+		if approver != "" {
+			return admission.Denied(fmt.Sprintf("the %s annotation can't be set when the Release is created",
+				ApprovedByAnnotation))
+		}
+
+		return admission.Allowed("")
+	}
+
+	oldRelease := &Release{}
+	if err := v.decoder.DecodeRaw(req.OldObject, oldRelease); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if approver == "" || approver == oldRelease.GetAnnotations()[ApprovedByAnnotation] {
+		return admission.Allowed("")
+	}
+
+	if approver != req.UserInfo.Username {
+		return admission.Denied(fmt.Sprintf("the %s annotation can only be set by the user it names",
+			ApprovedByAnnotation))
+	}
+
+	if !oldRelease.IsApprover(approver) {
+		return admission.Denied(fmt.Sprintf("user %s is not an approver of the Release", approver))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateLabelsToResults throws an error if any of the labels in the Release spec.labelsToResults is not a valid label.
+func (r *Release) validateLabelsToResults() error {
+	for label := range r.Spec.LabelsToResults {
+		if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+			return fmt.Errorf("invalid label '%s' in labelsToResults: %s", label, errs[0])
+		}
+	}
+
+	return nil
+}
+
+// validateLabels throws an error if any of the labels in the Release spec is not a valid label or uses a reserved
+// prefix.
+func (r *Release) validateLabels() error {
+	for key, value := range r.Spec.Labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label '%s' in labels: %s", key, errs[0])
+		}
+
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid value '%s' for label '%s' in labels: %s", value, key, errs[0])
+		}
+
+		if isReservedLabel(key) {
+			return fmt.Errorf("label '%s' in labels uses a reserved prefix", key)
+		}
+	}
+
+	return nil
+}
+
+// isReservedLabel returns whether the prefix of the given label key belongs to one of the reserved label domains.
+func isReservedLabel(key string) bool {
+	separator := strings.Index(key, "/")
+	if separator < 0 {
+		return false
+	}
+
+	prefix := key[:separator]
+	for _, domain := range reservedLabelDomains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
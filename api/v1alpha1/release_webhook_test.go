@@ -17,11 +17,17 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -49,11 +55,80 @@ var _ = Describe("Release validation webhook", func() {
 		_ = k8sClient.Delete(ctx, release)
 	})
 
+	Context("Create Release CR", func() {
+		It("Should error out when labelsToResults contains an invalid label", func() {
+			release.Spec.LabelsToResults = map[string]string{"invalid label!": "result"}
+
+			err := k8sClient.Create(ctx, release)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("invalid label 'invalid label!' in labelsToResults"))
+		})
+
+		It("Should not error out when labelsToResults contains valid labels", func() {
+			release.Spec.LabelsToResults = map[string]string{"release.appstudio.openshift.io/digest": "image-digest"}
+
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+		})
+
+		It("Should promote the spec labels to the Release metadata", func() {
+			release.Spec.Labels = map[string]string{"example.com/team": "payments"}
+
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+			Expect(release.Labels).To(HaveKeyWithValue("example.com/team", "payments"))
+		})
+
+		It("Should error out when two collectors have the same name", func() {
+			release.Spec.Collectors = []Collector{
+				{Name: "sbom", Task: "collect-sbom"},
+				{Name: "sbom", Task: "collect-other-sbom"},
+			}
+
+			err := k8sClient.Create(ctx, release)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("Duplicate value"))
+		})
+
+		It("Should error out when labels contains a label with a reserved prefix", func() {
+			release.Spec.Labels = map[string]string{"release.appstudio.openshift.io/outcome": "succeeded"}
+
+			err := k8sClient.Create(ctx, release)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(
+				"label 'release.appstudio.openshift.io/outcome' in labels uses a reserved prefix"))
+		})
+	})
+
 	Context("Update Release CR fields", func() {
-		It("Should error out when updating the resource", func() {
+		It("Should not error out when updating the resource before the release PipelineRun is triggered", func() {
+			ctx := context.Background()
+
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+
+			// Try to update the Release snapshot
+			release.Spec.Snapshot = "another-snapshot"
+
+			Expect(k8sClient.Update(ctx, release)).Should(Succeed())
+		})
+
+		It("Should error out when updating the labels to use a reserved prefix", func() {
+			ctx := context.Background()
+
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+
+			release.Spec.Labels = map[string]string{"release.appstudio.openshift.io/outcome": "succeeded"}
+
+			err := k8sClient.Update(ctx, release)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(
+				"label 'release.appstudio.openshift.io/outcome' in labels uses a reserved prefix"))
+		})
+
+		It("Should error out when updating the resource once the release PipelineRun is triggered", func() {
 			ctx := context.Background()
 
 			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+			release.MarkRunning()
+			Expect(k8sClient.Status().Update(ctx, release)).Should(Succeed())
 
 			// Try to update the Release snapshot
 			release.Spec.Snapshot = "another-snapshot"
@@ -63,6 +138,17 @@ var _ = Describe("Release validation webhook", func() {
 			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
 		})
 
+		It("Should restore the spec labels removed from the Release metadata", func() {
+			ctx := context.Background()
+
+			release.Spec.Labels = map[string]string{"team": "payments"}
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+
+			delete(release.Labels, "team")
+			Expect(k8sClient.Update(ctx, release)).Should(Succeed())
+			Expect(release.Labels).To(HaveKeyWithValue("team", "payments"))
+		})
+
 		It("Should not error out when updating the resource metadata", func() {
 			ctx := context.Background()
 
@@ -77,10 +163,194 @@ var _ = Describe("Release validation webhook", func() {
 		})
 	})
 
+	Describe("When Default method is called", func() {
+		It("should add the spec labels to the Release metadata", func() {
+			release.Labels = map[string]string{"foo": "bar"}
+			release.Spec.Labels = map[string]string{"team": "payments"}
+			release.Default()
+			Expect(release.Labels).To(Equal(map[string]string{"foo": "bar", "team": "payments"}))
+		})
+
+		It("should leave the Release metadata untouched if there are no spec labels", func() {
+			release.Default()
+			Expect(release.Labels).To(BeNil())
+		})
+	})
+
+	Describe("When ValidateCreate method is called", func() {
+		It("should return an error if a spec label is not a valid label", func() {
+			release.Spec.Labels = map[string]string{"invalid label!": "value"}
+			Expect(release.ValidateCreate()).To(HaveOccurred())
+		})
+
+		It("should return an error if a spec label value is not valid", func() {
+			release.Spec.Labels = map[string]string{"team": "invalid value!"}
+			Expect(release.ValidateCreate()).To(HaveOccurred())
+		})
+
+		It("should return an error if a spec label uses a reserved prefix or any of its subdomains", func() {
+			release.Spec.Labels = map[string]string{"app.kubernetes.io/name": "value"}
+			Expect(release.ValidateCreate()).To(MatchError("label 'app.kubernetes.io/name' in labels uses a reserved prefix"))
+		})
+
+		It("should return nil if the spec labels don't use a reserved prefix", func() {
+			release.Spec.Labels = map[string]string{"team": "payments", "example.com/cost-center": "42", "notk8s.io/foo": "bar"}
+			Expect(release.ValidateCreate()).To(BeNil())
+		})
+	})
+
+	Describe("When ValidateUpdate method is called", func() {
+		It("should return nil if the spec is updated before the release PipelineRun is triggered", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.Snapshot = "another-snapshot"
+			Expect(updatedRelease.ValidateUpdate(release)).To(BeNil())
+		})
+
+		It("should return a field error if the spec is updated once the release PipelineRun is triggered", func() {
+			release.MarkRunning()
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.Snapshot = "another-snapshot"
+
+			err := updatedRelease.ValidateUpdate(release)
+			Expect(err).To(BeAssignableToTypeOf(&field.Error{}))
+			Expect(err.(*field.Error).Type).To(Equal(field.ErrorTypeForbidden))
+			Expect(err.(*field.Error).Field).To(Equal("spec"))
+		})
+
+		It("should return a field error if the approvers are updated", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.Approvers = []string{"bob"}
+
+			err := updatedRelease.ValidateUpdate(release)
+			Expect(err).To(BeAssignableToTypeOf(&field.Error{}))
+			Expect(err.(*field.Error).Type).To(Equal(field.ErrorTypeForbidden))
+			Expect(err.(*field.Error).Field).To(Equal("spec.approvers"))
+		})
+
+		It("should return an error if a spec label is updated to use a reserved prefix", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.Labels = map[string]string{"release.appstudio.openshift.io/outcome": "succeeded"}
+			Expect(updatedRelease.ValidateUpdate(release)).To(MatchError(
+				"label 'release.appstudio.openshift.io/outcome' in labels uses a reserved prefix"))
+		})
+
+		It("should return an error if labelsToResults is updated with an invalid label", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.LabelsToResults = map[string]string{"invalid label!": "result"}
+			Expect(updatedRelease.ValidateUpdate(release)).To(MatchError(
+				ContainSubstring("invalid label 'invalid label!' in labelsToResults")))
+		})
+
+		It("should return nil if only the metadata is updated once the release PipelineRun is triggered", func() {
+			release.MarkRunning()
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Annotations = map[string]string{"foo": "bar"}
+			Expect(updatedRelease.ValidateUpdate(release)).To(BeNil())
+		})
+	})
+
 	Describe("When ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			release := &Release{}
 			Expect(release.ValidateDelete()).To(BeNil())
 		})
 	})
+
+	Describe("When the approval webhook handles a request", func() {
+		var validator *releaseApprovalValidator
+
+		newRequest := func(operation admissionv1.Operation, username string, release, oldRelease *Release) admission.Request {
+			request := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: operation,
+					UserInfo:  authenticationv1.UserInfo{Username: username},
+				},
+			}
+			request.Object.Raw, _ = json.Marshal(release)
+			if oldRelease != nil {
+				request.OldObject.Raw, _ = json.Marshal(oldRelease)
+			}
+			return request
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(AddToScheme(scheme)).To(Succeed())
+			decoder, err := admission.NewDecoder(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			validator = &releaseApprovalValidator{}
+			Expect(validator.InjectDecoder(decoder)).To(Succeed())
+
+			release.Spec.Approvers = []string{"alice"}
+		})
+
+		It("should allow requests not setting the approved-by annotation", func() {
+			response := validator.Handle(ctx, newRequest(admissionv1.Create, "bob", release, nil))
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		It("should allow an approver to approve the release", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "alice"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Update, "alice", approvedRelease, release))
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		It("should deny approvals on behalf of another user", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "alice"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Update, "bob", approvedRelease, release))
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("can only be set by the user it names"))
+		})
+
+		It("should deny approvals from users who are not approvers", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "bob"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Update, "bob", approvedRelease, release))
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("user bob is not an approver"))
+		})
+
+		It("should deny approvals set when the release is created", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "alice"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Create, "alice", approvedRelease, nil))
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("can't be set when the Release is created"))
+		})
+
+		It("should deny self-approvals of users adding themselves to the approvers when creating the release", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.Spec.Approvers = []string{"bob"}
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "bob"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Create, "bob", approvedRelease, nil))
+			Expect(response.Allowed).To(BeFalse())
+		})
+
+		It("should deny approvals from users adding themselves to the approvers in the same request", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.Spec.Approvers = append(approvedRelease.Spec.Approvers, "bob")
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "bob"})
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Update, "bob", approvedRelease, release))
+			Expect(response.Allowed).To(BeFalse())
+		})
+
+		It("should allow other users to update an approved release", func() {
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{ApprovedByAnnotation: "alice"})
+			updatedRelease := approvedRelease.DeepCopy()
+			updatedRelease.Labels = map[string]string{"foo": "bar"}
+
+			response := validator.Handle(ctx, newRequest(admissionv1.Update, "bob", updatedRelease, approvedRelease))
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
 })
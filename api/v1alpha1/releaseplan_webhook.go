@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -50,12 +52,20 @@ var _ webhook.Validator = &ReleasePlan{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (rp *ReleasePlan) ValidateCreate() error {
-	return rp.validateAutoReleaseLabel()
+	if err := rp.validateAutoReleaseLabel(); err != nil {
+		return err
+	}
+
+	return rp.validateTargetAndApplication()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (rp *ReleasePlan) ValidateUpdate(old runtime.Object) error {
-	return rp.validateAutoReleaseLabel()
+	if err := rp.validateAutoReleaseLabel(); err != nil {
+		return err
+	}
+
+	return rp.validateTargetAndApplication()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -72,3 +82,21 @@ func (rp *ReleasePlan) validateAutoReleaseLabel() error {
 	}
 	return nil
 }
+
+// validateTargetAndApplication throws an error if the ReleasePlan doesn't set its application or target, or if it
+// targets its own namespace.
+func (rp *ReleasePlan) validateTargetAndApplication() error {
+	if strings.TrimSpace(rp.Spec.Application) == "" {
+		return fmt.Errorf("the ReleasePlan application cannot be empty")
+	}
+
+	if strings.TrimSpace(rp.Spec.Target) == "" {
+		return fmt.Errorf("the ReleasePlan target cannot be empty")
+	}
+
+	if rp.Spec.Target == rp.Namespace {
+		return fmt.Errorf("the ReleasePlan target cannot be its own namespace '%s'", rp.Namespace)
+	}
+
+	return nil
+}
@@ -41,7 +41,7 @@ var _ = Describe("ReleasePlan webhook", func() {
 			Spec: ReleasePlanSpec{
 				DisplayName: "Test release plan",
 				Application: "application",
-				Target:      "default",
+				Target:      "managed",
 			},
 		}
 	})
@@ -122,6 +122,44 @@ var _ = Describe("ReleasePlan webhook", func() {
 		})
 	})
 
+	Context("When a ReleasePlan targets its own namespace", func() {
+		It("should get rejected", func() {
+			releasePlan.Spec.Target = releasePlan.Namespace
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the ReleasePlan target cannot be its own namespace"))
+		})
+	})
+
+	Describe("When ValidateCreate method is called", func() {
+		It("should accept a ReleasePlan with an application and a target in another namespace", func() {
+			Expect(releasePlan.ValidateCreate()).To(Succeed())
+		})
+
+		It("should reject a ReleasePlan without application", func() {
+			releasePlan.Spec.Application = " "
+			Expect(releasePlan.ValidateCreate()).To(MatchError(ContainSubstring("application cannot be empty")))
+		})
+
+		It("should reject a ReleasePlan without target", func() {
+			releasePlan.Spec.Target = ""
+			Expect(releasePlan.ValidateCreate()).To(MatchError(ContainSubstring("target cannot be empty")))
+		})
+
+		It("should reject a ReleasePlan targeting its own namespace", func() {
+			releasePlan.Spec.Target = releasePlan.Namespace
+			Expect(releasePlan.ValidateCreate()).To(MatchError(ContainSubstring("target cannot be its own namespace")))
+		})
+	})
+
+	Describe("When ValidateUpdate method is called", func() {
+		It("should reject an update making the ReleasePlan target its own namespace", func() {
+			updatedReleasePlan := releasePlan.DeepCopy()
+			updatedReleasePlan.Spec.Target = releasePlan.Namespace
+			Expect(updatedReleasePlan.ValidateUpdate(releasePlan)).To(MatchError(ContainSubstring("target cannot be its own namespace")))
+		})
+	})
+
 	Describe("When ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			releaseplan := &ReleasePlan{}
@@ -45,6 +45,11 @@ type ReleasePlanAdmissionSpec struct {
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +required
 	ReleaseStrategy string `json:"releaseStrategy"`
+
+	// ReleaseStrategyArtifact is a reference to an OCI artifact containing the ReleaseStrategy to use. If it's set,
+	// the ReleaseStrategy is read from the artifact instead of the cluster
+	// +optional
+	ReleaseStrategyArtifact string `json:"releaseStrategyArtifact,omitempty"`
 }
 
 // ReleasePlanAdmissionStatus defines the observed state of ReleasePlanAdmission.
@@ -17,7 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ReleaseStrategySpec defines the desired state of ReleaseStrategy
@@ -45,11 +49,57 @@ type ReleaseStrategySpec struct {
 	// +optional
 	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
 
+	// Workspaces are additional release Pipeline workspaces, each backed by an existing pvc or by a pvc created for
+	// the release PipelineRun from a template
+	// +optional
+	Workspaces []Workspace `json:"workspaces,omitempty"`
+
 	// ServiceAccount is the name of the service account to use in the
-	// release PipelineRun to gain elevated privileges
+	// release PipelineRun to gain elevated privileges. If not set, the
+	// default service account configured in the operator is used
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// PipelineServiceAccountPerTask maps names of tasks of the release Pipeline to the service account their TaskRuns
+	// should run with instead of the one of the release PipelineRun
+	// +optional
+	PipelineServiceAccountPerTask map[string]string `json:"pipelineServiceAccountPerTask,omitempty"`
+
+	// ServiceAccountToken requests a bound token of the release PipelineRun service account to be mounted in one of
+	// the release Pipeline workspaces. The token is minted when the PipelineRun pods start, so it never appears in the
+	// PipelineRun params or in the Release status
+	// +optional
+	ServiceAccountToken *ServiceAccountToken `json:"serviceAccountToken,omitempty"`
+
+	// MinReleaseInterval is the minimum time to wait after a successful Release of an application before another
+	// Release of the same application can be processed
+	// +optional
+	MinReleaseInterval *metav1.Duration `json:"minReleaseInterval,omitempty"`
+
+	// ProvenanceGracePeriod is the time to wait after a successful Release for Tekton Chains to sign the release
+	// PipelineRun. If set, Releases whose PipelineRun is not signed within this period are marked as missing their
+	// provenance and are not deployed
+	// +optional
+	ProvenanceGracePeriod *metav1.Duration `json:"provenanceGracePeriod,omitempty"`
+
+	// Timeout is the maximum time the release PipelineRun can run for. If it's not set and the release Pipeline doesn't
+	// bound the run either, the default timeout configured in the operator is used
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// PipelineRunTemplate is a Tekton PipelineRunSpec used as the base of the release PipelineRun. The pipeline
+	// reference, params, workspaces, service account and timeout resolved for the Release are merged onto it, replacing
+	// the template values with the same name. The template can't reference a pipeline or set the PipelineRun status
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// +optional
+	PipelineRunTemplate *runtime.RawExtension `json:"pipelineRunTemplate,omitempty"`
+
+	// Webhooks to notify once a Release using this strategy finishes. They are notified in the background, only once,
+	// after the outcome of the Release has been persisted in its status
+	// +optional
+	Webhooks *Webhooks `json:"webhooks,omitempty"`
 }
 
 // Params holds the definition of a parameter that should be passed to the release Pipeline
@@ -64,6 +114,73 @@ type Params struct {
 	Values []string `json:"values,omitempty"`
 }
 
+// ServiceAccountToken holds the definition of a bound service account token mounted in the release PipelineRun
+type ServiceAccountToken struct {
+	// Workspace is the name of the release Pipeline workspace where the token is mounted. The token is stored in the
+	// "token" file of the workspace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Workspace string `json:"workspace"`
+
+	// Audience is the intended audience of the token. If not set, the token is issued for the API server
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// ExpirationSeconds is the requested validity of the token in seconds. If not set, the token is valid for an hour
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// Workspace holds the definition of a release Pipeline workspace backed by a pvc. Exactly one of PersistentVolumeClaim
+// and VolumeClaimTemplate has to be set
+type Workspace struct {
+	// Name is the name of the release Pipeline workspace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +required
+	Name string `json:"name"`
+
+	// PersistentVolumeClaim is the name of an existing pvc in the release PipelineRun namespace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+
+	// VolumeClaimTemplate is the template of the pvc created for each release PipelineRun. The pvc is owned by the
+	// PipelineRun, so it's deleted along with it
+	// +kubebuilder:validation:EmbeddedResource
+	// +optional
+	VolumeClaimTemplate *corev1.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
+}
+
+// Webhooks holds the endpoints to notify depending on the outcome of a Release
+type Webhooks struct {
+	// OnSuccess is the webhook to notify when a Release succeeds
+	// +optional
+	OnSuccess *Webhook `json:"onSuccess,omitempty"`
+
+	// OnFailure is the webhook to notify when a Release fails
+	// +optional
+	OnFailure *Webhook `json:"onFailure,omitempty"`
+}
+
+// Webhook holds the definition of an endpoint to notify
+type Webhook struct {
+	// URL is the endpoint where the notification will be posted
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	// +required
+	URL string `json:"url"`
+}
+
+const (
+	// DeprecatedAnnotation is the annotation name that can be set to true in ReleaseStrategies to mark them as
+	// deprecated. Releases using a deprecated ReleaseStrategy are still processed, but a warning event is recorded
+	DeprecatedAnnotation = "release.appstudio.openshift.io/deprecated"
+
+	// ReplacedByAnnotation is the annotation name that can be set in deprecated ReleaseStrategies to point to the
+	// ReleaseStrategy replacing them
+	ReplacedByAnnotation = "release.appstudio.openshift.io/replaced-by"
+)
+
 // ReleaseStrategyStatus defines the observed state of ReleaseStrategy
 type ReleaseStrategyStatus struct {
 }
@@ -80,6 +197,12 @@ type ReleaseStrategy struct {
 	Status ReleaseStrategyStatus `json:"status,omitempty"`
 }
 
+// IsDeprecated checks whether the ReleaseStrategy is marked as deprecated by the deprecated annotation.
+func (rs *ReleaseStrategy) IsDeprecated() bool {
+	deprecated, err := strconv.ParseBool(rs.GetAnnotations()[DeprecatedAnnotation])
+	return err == nil && deprecated
+}
+
 //+kubebuilder:object:root=true
 
 // ReleaseStrategyList contains a list of ReleaseStrategy
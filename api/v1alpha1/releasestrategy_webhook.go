@@ -0,0 +1,154 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (rs *ReleaseStrategy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(rs).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-appstudio-redhat-com-v1alpha1-releasestrategy,mutating=true,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releasestrategies,verbs=create;update,versions=v1alpha1,name=mreleasestrategy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ReleaseStrategy{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (rs *ReleaseStrategy) Default() {
+	for i := range rs.Spec.Params {
+		rs.Spec.Params[i].Name = normalizeParamName(rs.Spec.Params[i].Name)
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-appstudio-redhat-com-v1alpha1-releasestrategy,mutating=false,failurePolicy=fail,sideEffects=None,groups=appstudio.redhat.com,resources=releasestrategies,verbs=create;update,versions=v1alpha1,name=vreleasestrategy.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ReleaseStrategy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (rs *ReleaseStrategy) ValidateCreate() error {
+	return rs.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (rs *ReleaseStrategy) ValidateUpdate(old runtime.Object) error {
+	return rs.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (rs *ReleaseStrategy) ValidateDelete() error {
+	return nil
+}
+
+// Validate throws an error if the params, workspaces or PipelineRun template of the ReleaseStrategy are not valid. It's
+// run by the webhook and when a ReleaseStrategy is resolved from an OCI artifact, as those are never admitted.
+func (rs *ReleaseStrategy) Validate() error {
+	if err := rs.validateParamNames(); err != nil {
+		return err
+	}
+
+	if err := rs.validateWorkspaces(); err != nil {
+		return err
+	}
+
+	return rs.validatePipelineRunTemplate()
+}
+
+// validateParamNames throws an error if two params have the same name once normalized.
+func (rs *ReleaseStrategy) validateParamNames() error {
+	names := make(map[string]string, len(rs.Spec.Params))
+	for _, param := range rs.Spec.Params {
+		name := normalizeParamName(param.Name)
+		if previous, found := names[name]; found {
+			return fmt.Errorf("params '%s' and '%s' collide once normalized to '%s'", previous, param.Name, name)
+		}
+		names[name] = param.Name
+	}
+
+	return nil
+}
+
+// validatePipelineRunTemplate throws an error if the PipelineRun template is not a valid PipelineRunSpec or it sets
+// fields owned by the ReleaseStrategy, like the reference to the release pipeline.
+func (rs *ReleaseStrategy) validatePipelineRunTemplate() error {
+	if rs.Spec.PipelineRunTemplate == nil {
+		return nil
+	}
+
+	template := &tektonv1beta1.PipelineRunSpec{}
+	decoder := json.NewDecoder(bytes.NewReader(rs.Spec.PipelineRunTemplate.Raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(template); err != nil {
+		return fmt.Errorf("invalid pipelineRunTemplate: %w", err)
+	}
+
+	if template.PipelineRef != nil || template.PipelineSpec != nil {
+		return fmt.Errorf("pipelineRunTemplate can't reference a pipeline, as the ReleaseStrategy pipeline is used")
+	}
+
+	if template.Status != "" {
+		return fmt.Errorf("pipelineRunTemplate can't set the PipelineRun status")
+	}
+
+	return nil
+}
+
+// validateWorkspaces throws an error if a workspace has no name, two workspaces have the same name or a workspace isn't
+// backed by exactly one of an existing pvc or a pvc template.
+func (rs *ReleaseStrategy) validateWorkspaces() error {
+	names := make(map[string]bool, len(rs.Spec.Workspaces))
+	for _, workspace := range rs.Spec.Workspaces {
+		if workspace.Name == "" {
+			return fmt.Errorf("workspaces must have a name")
+		}
+
+		if names[workspace.Name] {
+			return fmt.Errorf("workspace '%s' is declared more than once", workspace.Name)
+		}
+		names[workspace.Name] = true
+
+		if (workspace.PersistentVolumeClaim == "") == (workspace.VolumeClaimTemplate == nil) {
+			return fmt.Errorf("workspace '%s' must set exactly one of persistentVolumeClaim and volumeClaimTemplate",
+				workspace.Name)
+		}
+	}
+
+	return nil
+}
+
+// normalizeParamName returns the given param name without leading and trailing whitespace. If the
+// LOWERCASE_PARAM_NAMES environment variable is set to true, the name is lowercased too.
+func normalizeParamName(name string) string {
+	name = strings.TrimSpace(name)
+	if lowercase, err := strconv.ParseBool(os.Getenv("LOWERCASE_PARAM_NAMES")); err == nil && lowercase {
+		name = strings.ToLower(name)
+	}
+
+	return name
+}
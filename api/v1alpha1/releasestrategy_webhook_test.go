@@ -0,0 +1,191 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	//+kubebuilder:scaffold:imports
+)
+
+var _ = Describe("ReleaseStrategy webhook", func() {
+	var releaseStrategy *ReleaseStrategy
+
+	BeforeEach(func() {
+		releaseStrategy = &ReleaseStrategy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "appstudio.redhat.com/v1alpha1",
+				Kind:       "ReleaseStrategy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "releasestrategy",
+				Namespace: "default",
+			},
+			Spec: ReleaseStrategySpec{
+				Pipeline: "release-pipeline",
+				Policy:   "policy",
+				Params: []Params{
+					{Name: " foo ", Value: "bar"},
+					{Name: "Baz", Value: "qux"},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		err := k8sClient.Delete(ctx, releaseStrategy)
+		Expect(err == nil || errors.IsNotFound(err)).To(BeTrue())
+		os.Unsetenv("LOWERCASE_PARAM_NAMES")
+	})
+
+	Context("When a ReleaseStrategy is created", func() {
+		It("should get its param names normalized", func() {
+			Expect(k8sClient.Create(ctx, releaseStrategy)).Should(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      releaseStrategy.Name,
+					Namespace: releaseStrategy.Namespace,
+				}, releaseStrategy)
+
+				return err == nil && releaseStrategy.Spec.Params[0].Name == "foo" &&
+					releaseStrategy.Spec.Params[1].Name == "Baz"
+			}, timeout).Should(BeTrue())
+		})
+
+		It("should get rejected if two param names collide once normalized", func() {
+			releaseStrategy.Spec.Params = append(releaseStrategy.Spec.Params, Params{Name: "foo", Value: "other"})
+			err := k8sClient.Create(ctx, releaseStrategy)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("collide once normalized to 'foo'"))
+		})
+	})
+
+	Describe("When Default method is called", func() {
+		It("should trim the param names", func() {
+			releaseStrategy.Default()
+			Expect(releaseStrategy.Spec.Params[0].Name).To(Equal("foo"))
+			Expect(releaseStrategy.Spec.Params[1].Name).To(Equal("Baz"))
+		})
+
+		It("should lowercase the param names if enabled", func() {
+			os.Setenv("LOWERCASE_PARAM_NAMES", "true")
+			releaseStrategy.Default()
+			Expect(releaseStrategy.Spec.Params[0].Name).To(Equal("foo"))
+			Expect(releaseStrategy.Spec.Params[1].Name).To(Equal("baz"))
+		})
+	})
+
+	Describe("When ValidateCreate method is called", func() {
+		It("should return nil if the param names are unique", func() {
+			Expect(releaseStrategy.ValidateCreate()).To(BeNil())
+		})
+
+		It("should return an error if two param names are the same once trimmed", func() {
+			releaseStrategy.Spec.Params = append(releaseStrategy.Spec.Params, Params{Name: "foo"})
+			Expect(releaseStrategy.ValidateCreate()).To(MatchError("params ' foo ' and 'foo' collide once normalized to 'foo'"))
+		})
+
+		It("should only treat names differing in case as a collision if lowercasing is enabled", func() {
+			releaseStrategy.Spec.Params = append(releaseStrategy.Spec.Params, Params{Name: "baz"})
+			Expect(releaseStrategy.ValidateCreate()).To(BeNil())
+
+			os.Setenv("LOWERCASE_PARAM_NAMES", "true")
+			Expect(releaseStrategy.ValidateCreate()).To(HaveOccurred())
+		})
+	})
+
+	Describe("When validatePipelineRunTemplate method is called", func() {
+		It("should return nil if there is no template", func() {
+			Expect(releaseStrategy.validatePipelineRunTemplate()).To(BeNil())
+		})
+
+		It("should return nil if the template is a valid PipelineRunSpec", func() {
+			releaseStrategy.Spec.PipelineRunTemplate = &runtime.RawExtension{
+				Raw: []byte(`{"serviceAccountName": "sa", "timeouts": {"tasks": "30m"}, "params": [{"name": "foo", "value": "bar"}]}`),
+			}
+			Expect(releaseStrategy.validatePipelineRunTemplate()).To(BeNil())
+		})
+
+		It("should return an error if the template has unknown fields", func() {
+			releaseStrategy.Spec.PipelineRunTemplate = &runtime.RawExtension{Raw: []byte(`{"unknown": "field"}`)}
+			Expect(releaseStrategy.validatePipelineRunTemplate()).To(MatchError(ContainSubstring("invalid pipelineRunTemplate")))
+		})
+
+		It("should return an error if the template references a pipeline", func() {
+			releaseStrategy.Spec.PipelineRunTemplate = &runtime.RawExtension{Raw: []byte(`{"pipelineRef": {"name": "other"}}`)}
+			Expect(releaseStrategy.validatePipelineRunTemplate()).To(MatchError(ContainSubstring("can't reference a pipeline")))
+		})
+
+		It("should return an error if the template sets the PipelineRun status", func() {
+			releaseStrategy.Spec.PipelineRunTemplate = &runtime.RawExtension{Raw: []byte(`{"status": "Cancelled"}`)}
+			Expect(releaseStrategy.ValidateCreate()).To(MatchError(ContainSubstring("can't set the PipelineRun status")))
+		})
+	})
+
+	Describe("When validateWorkspaces method is called", func() {
+		It("should return nil if the workspaces are backed by an existing pvc or a pvc template", func() {
+			releaseStrategy.Spec.Workspaces = []Workspace{
+				{Name: "signing", PersistentVolumeClaim: "signing-pvc"},
+				{Name: "scratch", VolumeClaimTemplate: &corev1.PersistentVolumeClaim{}},
+			}
+			Expect(releaseStrategy.validateWorkspaces()).To(BeNil())
+		})
+
+		It("should return an error if a workspace has no name", func() {
+			releaseStrategy.Spec.Workspaces = []Workspace{{PersistentVolumeClaim: "signing-pvc"}}
+			Expect(releaseStrategy.validateWorkspaces()).To(MatchError("workspaces must have a name"))
+		})
+
+		It("should return an error if two workspaces have the same name", func() {
+			releaseStrategy.Spec.Workspaces = []Workspace{
+				{Name: "signing", PersistentVolumeClaim: "signing-pvc"},
+				{Name: "signing", PersistentVolumeClaim: "other-pvc"},
+			}
+			Expect(releaseStrategy.validateWorkspaces()).To(MatchError(ContainSubstring("declared more than once")))
+		})
+
+		It("should return an error if a workspace isn't backed by exactly one pvc", func() {
+			releaseStrategy.Spec.Workspaces = []Workspace{{Name: "signing"}}
+			Expect(releaseStrategy.ValidateCreate()).To(MatchError(ContainSubstring("exactly one of")))
+
+			releaseStrategy.Spec.Workspaces[0].PersistentVolumeClaim = "signing-pvc"
+			releaseStrategy.Spec.Workspaces[0].VolumeClaimTemplate = &corev1.PersistentVolumeClaim{}
+			Expect(releaseStrategy.ValidateUpdate(&ReleaseStrategy{})).To(MatchError(ContainSubstring("exactly one of")))
+		})
+	})
+
+	Describe("When ValidateUpdate method is called", func() {
+		It("should return an error if two param names collide once normalized", func() {
+			releaseStrategy.Spec.Params = append(releaseStrategy.Spec.Params, Params{Name: "foo"})
+			Expect(releaseStrategy.ValidateUpdate(&ReleaseStrategy{})).To(HaveOccurred())
+		})
+	})
+
+	Describe("When ValidateDelete method is called", func() {
+		It("should return nil", func() {
+			Expect(releaseStrategy.ValidateDelete()).To(BeNil())
+		})
+	})
+})
@@ -97,6 +97,7 @@ var _ = BeforeSuite(func() {
 	Expect((&Release{}).SetupWebhookWithManager(mgr)).To(Succeed())
 	Expect((&ReleasePlanAdmission{}).SetupWebhookWithManager(mgr)).To(Succeed())
 	Expect((&ReleasePlan{}).SetupWebhookWithManager(mgr)).To(Succeed())
+	Expect((&ReleaseStrategy{}).SetupWebhookWithManager(mgr)).To(Succeed())
 
 	//+kubebuilder:scaffold:webhook
 
@@ -22,10 +22,78 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Collector) DeepCopyInto(out *Collector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Collector.
+func (in *Collector) DeepCopy() *Collector {
+	if in == nil {
+		return nil
+	}
+	out := new(Collector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorResult) DeepCopyInto(out *CollectorResult) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorResult.
+func (in *CollectorResult) DeepCopy() *CollectorResult {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamDiff) DeepCopyInto(out *ParamDiff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamDiff.
+func (in *ParamDiff) DeepCopy() *ParamDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Params) DeepCopyInto(out *Params) {
 	*out = *in
@@ -46,12 +114,28 @@ func (in *Params) DeepCopy() *Params {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReasonTransition) DeepCopyInto(out *ReasonTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReasonTransition.
+func (in *ReasonTransition) DeepCopy() *ReasonTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ReasonTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Release) DeepCopyInto(out *Release) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -283,9 +367,80 @@ func (in *ReleasePlanStatus) DeepCopy() *ReleasePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseResult) DeepCopyInto(out *ReleaseResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseResult.
+func (in *ReleaseResult) DeepCopy() *ReleaseResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
 	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelsToResults != nil {
+		in, out := &in.LabelsToResults, &out.LabelsToResults
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResultsOutput != nil {
+		in, out := &in.ResultsOutput, &out.ResultsOutput
+		*out = new(ResultsOutput)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TTLSecondsAfterSuccess != nil {
+		in, out := &in.TTLSecondsAfterSuccess, &out.TTLSecondsAfterSuccess
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFailure != nil {
+		in, out := &in.TTLSecondsAfterFailure, &out.TTLSecondsAfterFailure
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NotificationChannels != nil {
+		in, out := &in.NotificationChannels, &out.NotificationChannels
+		*out = make([]NotificationChannel, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Collectors != nil {
+		in, out := &in.Collectors, &out.Collectors
+		*out = make([]Collector, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
@@ -324,6 +479,49 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PipelineRunRef != nil {
+		in, out := &in.PipelineRunRef, &out.PipelineRunRef
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]Params, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceAnnotations != nil {
+		in, out := &in.SourceAnnotations, &out.SourceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ParamsDiff != nil {
+		in, out := &in.ParamsDiff, &out.ParamsDiff
+		*out = make([]ParamDiff, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReasonHistory != nil {
+		in, out := &in.ReasonHistory, &out.ReasonHistory
+		*out = make([]ReasonTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CollectorResults != nil {
+		in, out := &in.CollectorResults, &out.CollectorResults
+		*out = make([]CollectorResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]ReleaseResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStatus.
@@ -405,6 +603,50 @@ func (in *ReleaseStrategySpec) DeepCopyInto(out *ReleaseStrategySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]Workspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PipelineServiceAccountPerTask != nil {
+		in, out := &in.PipelineServiceAccountPerTask, &out.PipelineServiceAccountPerTask
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountToken)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinReleaseInterval != nil {
+		in, out := &in.MinReleaseInterval, &out.MinReleaseInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProvenanceGracePeriod != nil {
+		in, out := &in.ProvenanceGracePeriod, &out.ProvenanceGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PipelineRunTemplate != nil {
+		in, out := &in.PipelineRunTemplate, &out.PipelineRunTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = new(Webhooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStrategySpec.
@@ -431,3 +673,98 @@ func (in *ReleaseStrategyStatus) DeepCopy() *ReleaseStrategyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsOutput) DeepCopyInto(out *ResultsOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsOutput.
+func (in *ResultsOutput) DeepCopy() *ResultsOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountToken) DeepCopyInto(out *ServiceAccountToken) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountToken.
+func (in *ServiceAccountToken) DeepCopy() *ServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Webhook) DeepCopyInto(out *Webhook) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Webhook.
+func (in *Webhook) DeepCopy() *Webhook {
+	if in == nil {
+		return nil
+	}
+	out := new(Webhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Webhooks) DeepCopyInto(out *Webhooks) {
+	*out = *in
+	if in.OnSuccess != nil {
+		in, out := &in.OnSuccess, &out.OnSuccess
+		*out = new(Webhook)
+		**out = **in
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(Webhook)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Webhooks.
+func (in *Webhooks) DeepCopy() *Webhooks {
+	if in == nil {
+		return nil
+	}
+	out := new(Webhooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workspace) DeepCopyInto(out *Workspace) {
+	*out = *in
+	if in.VolumeClaimTemplate != nil {
+		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
+		*out = new(corev1.PersistentVolumeClaim)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
+func (in *Workspace) DeepCopy() *Workspace {
+	if in == nil {
+		return nil
+	}
+	out := new(Workspace)
+	in.DeepCopyInto(out)
+	return out
+}
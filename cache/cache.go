@@ -18,9 +18,12 @@ package cache
 
 import (
 	"context"
+	"strings"
+
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,3 +56,25 @@ func SetupSnapshotEnvironmentBindingCache(mgr ctrl.Manager) error {
 	return mgr.GetCache().IndexField(context.Background(), &applicationapiv1alpha1.SnapshotEnvironmentBinding{},
 		"spec.environment", snapshotEnvironmentBindingIndexFunc)
 }
+
+// SetupWatchNamespaces restricts the cache of the manager built with the given options to the given comma-separated
+// list of namespaces. The indexes are built by the informers of each namespace, so ReleasePlanAdmissions can only be
+// found by origin in the watched namespaces, which have to include the targets of the watched ReleasePlans. If no
+// namespace is given, the options are left untouched and the whole cluster is watched.
+func SetupWatchNamespaces(options *ctrl.Options, watchNamespaces string) {
+	var namespaces []string
+	for _, namespace := range strings.Split(watchNamespaces, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	switch len(namespaces) {
+	case 0:
+		return
+	case 1:
+		options.Namespace = namespaces[0]
+	default:
+		options.NewCache = crcache.MultiNamespacedCacheBuilder(namespaces)
+	}
+}
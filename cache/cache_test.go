@@ -0,0 +1,58 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Cache", func() {
+	var options ctrl.Options
+
+	BeforeEach(func() {
+		options = ctrl.Options{}
+	})
+
+	Context("When SetupWatchNamespaces is called", func() {
+		It("watches the whole cluster if no namespace is given", func() {
+			SetupWatchNamespaces(&options, "")
+			Expect(options.Namespace).To(BeEmpty())
+			Expect(options.NewCache).To(BeNil())
+		})
+
+		It("ignores empty entries in the list of namespaces", func() {
+			SetupWatchNamespaces(&options, " , ")
+			Expect(options.Namespace).To(BeEmpty())
+			Expect(options.NewCache).To(BeNil())
+		})
+
+		It("restricts the cache to the namespace if only one is given", func() {
+			SetupWatchNamespaces(&options, " tenant ")
+			Expect(options.Namespace).To(Equal("tenant"))
+			Expect(options.NewCache).To(BeNil())
+		})
+
+		It("uses a cache spanning all the namespaces if several are given", func() {
+			SetupWatchNamespaces(&options, "tenant,managed")
+			Expect(options.Namespace).To(BeEmpty())
+			Expect(options.NewCache).NotTo(BeNil())
+		})
+	})
+})
@@ -25,6 +25,9 @@ import (
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/gitops"
 	"github.com/redhat-appstudio/release-service/loader"
+	"github.com/redhat-appstudio/release-service/metadata"
+	"github.com/redhat-appstudio/release-service/notifier"
+	"github.com/redhat-appstudio/release-service/oci"
 	"github.com/redhat-appstudio/release-service/syncer"
 	"github.com/redhat-appstudio/release-service/tekton"
 
@@ -36,24 +39,50 @@ import (
 
 	"github.com/go-logr/logr"
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
 	"knative.dev/pkg/apis"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 )
 
 // Adapter holds the objects needed to reconcile a Release.
 type Adapter struct {
 	client  client.Client
+	clock   clock.Clock
 	ctx     context.Context
 	loader  loader.ObjectLoader
 	logger  logr.Logger
 	release *v1alpha1.Release
 	syncer  *syncer.Syncer
+
+	// dispatcher is used to notify the outcome of the Release in the background. If it's nil, no notifications will
+	// be sent.
+	dispatcher *notifier.Dispatcher
+
+	// logSource is used to capture the logs of failed release PipelineRuns. If it's nil, no logs will be captured.
+	logSource tekton.LogSource
+
+	// recorder is used to record events for the major transitions of the Release. If it's nil, no events will be
+	// recorded.
+	recorder record.EventRecorder
+
+	// strategyResolver is used to resolve the ReleaseStrategies distributed as OCI artifacts. If it's nil, Releases
+	// whose ReleasePlanAdmission references a ReleaseStrategy artifact will fail their validation.
+	strategyResolver *oci.ReleaseStrategyResolver
+
+	// persistedRelease is a copy of the Release as last written to the cluster. It's used by FlushStatus to compute
+	// the status changes made by the operations.
+	persistedRelease *v1alpha1.Release
 }
 
 // finalizerName is the finalizer name to be added to the Releases
@@ -63,14 +92,50 @@ const finalizerName string = "appstudio.redhat.com/release-finalizer"
 func NewAdapter(ctx context.Context, client client.Client, release *v1alpha1.Release, loader loader.ObjectLoader, logger logr.Logger) *Adapter {
 	return &Adapter{
 		client:  client,
+		clock:   clock.RealClock{},
 		ctx:     ctx,
 		loader:  loader,
 		logger:  logger,
 		release: release,
 		syncer:  syncer.NewSyncerWithContext(client, logger, ctx),
+
+		persistedRelease: release.DeepCopy(),
 	}
 }
 
+// FlushStatus writes all the status changes made to the Release being processed since the last call in a single
+// patch, so a reconcile performs at most one status update. If there are no changes or the Release no longer exists,
+// nothing will be written. If the Release was modified by someone else since it was read, the latest version is
+// fetched and the status changes are applied on top of it again.
+func (a *Adapter) FlushStatus() error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		patch := client.MergeFrom(a.persistedRelease)
+		data, err := patch.Data(a.release)
+		if err != nil || string(data) == "{}" {
+			return err
+		}
+
+		err = a.client.Status().Patch(a.ctx, a.release, patch)
+		if errors.IsConflict(err) {
+			status := a.release.Status.DeepCopy()
+			if getErr := a.client.Get(a.ctx, client.ObjectKeyFromObject(a.release), a.release); getErr != nil {
+				return client.IgnoreNotFound(getErr)
+			}
+			a.persistedRelease = a.release.DeepCopy()
+			a.release.Status = *status
+
+			return err
+		}
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		a.persistedRelease = a.release.DeepCopy()
+
+		return nil
+	})
+}
+
 // EnsureFinalizersAreCalled is an operation that will ensure that finalizers are called whenever the Release being
 // processed is marked for deletion. Once finalizers get called, the finalizer will be removed and the Release will go
 // back to the queue, so it gets deleted. If a finalizer function fails its execution or a finalizer fails to be removed,
@@ -82,13 +147,25 @@ func (a *Adapter) EnsureFinalizersAreCalled() (reconciler.OperationResult, error
 	}
 
 	if controllerutil.ContainsFinalizer(a.release, finalizerName) {
+		pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release)
+		if err != nil {
+			return reconciler.RequeueWithError(err)
+		}
+
+		// Hold the finalizer so the failed PipelineRun isn't deleted until its retention period ends
+		if remaining := a.getRemainingPipelineRunRetention(pipelineRun); remaining > 0 {
+			a.logger.Info("Retaining the failed release PipelineRun before finalizing the Release",
+				"PipelineRun.Name", pipelineRun.Name, "Remaining", remaining.Round(time.Second))
+			return reconciler.RequeueAfter(remaining, nil)
+		}
+
 		if err := a.finalizeRelease(); err != nil {
 			return reconciler.RequeueWithError(err)
 		}
 
 		patch := client.MergeFrom(a.release.DeepCopy())
 		controllerutil.RemoveFinalizer(a.release, finalizerName)
-		err := a.client.Patch(a.ctx, a.release, patch)
+		err = a.patchRelease(patch)
 		if err != nil {
 			return reconciler.RequeueWithError(err)
 		}
@@ -98,6 +175,48 @@ func (a *Adapter) EnsureFinalizersAreCalled() (reconciler.OperationResult, error
 	return reconciler.Requeue()
 }
 
+// EnsureExpiredReleaseIsDeleted is an operation that will ensure that a Release that finished processing is deleted
+// once it outlives the TTL set for its outcome. Succeeded and failed Releases use different TTLs, so failures can be
+// kept longer for debugging. If the TTL hasn't elapsed yet, the Release will be requeued until it does. Releases with a
+// TTL of zero are deleted as soon as they finish, while Releases without a TTL are never deleted.
+func (a *Adapter) EnsureExpiredReleaseIsDeleted() (reconciler.OperationResult, error) {
+	// Releases collecting metadata are not finished until their collectors are
+	if !a.release.IsDone() || a.release.GetDeletionTimestamp() != nil || a.release.IsCollectingMetadata() {
+		return reconciler.ContinueProcessing()
+	}
+
+	ttl, finishTime := a.release.Spec.TTLSecondsAfterFailure, a.release.Status.CompletionTime
+	if a.release.HasSucceeded() {
+		// Successful Releases are not finished until their deployment is
+		if a.release.IsDeploying() && !a.release.IsDeployed() {
+			return reconciler.ContinueProcessing()
+		}
+
+		ttl = a.release.Spec.TTLSecondsAfterSuccess
+		if a.release.Status.DeploymentCompletionTime != nil {
+			finishTime = a.release.Status.DeploymentCompletionTime
+		}
+	}
+
+	if ttl == nil || finishTime == nil {
+		return reconciler.ContinueProcessing()
+	}
+
+	// A TTL of zero deletes the Release straight away, even if the completion time is slightly ahead of the clock
+	expirationTime := finishTime.Add(time.Duration(*ttl) * time.Second)
+	if remaining := expirationTime.Sub(a.clock.Now()); *ttl > 0 && remaining > 0 {
+		return reconciler.RequeueAfter(remaining, nil)
+	}
+
+	a.logger.Info("Deleting expired Release", "ExpirationTime", expirationTime)
+	err := a.client.Delete(a.ctx, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconciler.RequeueWithError(err)
+	}
+
+	return reconciler.StopProcessing()
+}
+
 // EnsureFinalizerIsAdded is an operation that will ensure that the Release being processed contains a finalizer.
 func (a *Adapter) EnsureFinalizerIsAdded() (reconciler.OperationResult, error) {
 	var finalizerFound bool
@@ -111,7 +230,7 @@ func (a *Adapter) EnsureFinalizerIsAdded() (reconciler.OperationResult, error) {
 		a.logger.Info("Adding Finalizer to the Release")
 		patch := client.MergeFrom(a.release.DeepCopy())
 		controllerutil.AddFinalizer(a.release, finalizerName)
-		err := a.client.Patch(a.ctx, a.release, patch)
+		err := a.patchRelease(patch)
 
 		return reconciler.RequeueOnErrorOrContinue(err)
 	}
@@ -119,69 +238,456 @@ func (a *Adapter) EnsureFinalizerIsAdded() (reconciler.OperationResult, error) {
 	return reconciler.ContinueProcessing()
 }
 
+// EnsureReleaseDependenciesAreMet is an operation that will ensure that all the Releases the Release being processed
+// depends on have succeeded before continuing. While they are still in progress, the Release will be marked as waiting
+// and requeued. If a dependency failed or the dependencies contain a cycle, no further operations will occur for this
+// Release.
+func (a *Adapter) EnsureReleaseDependenciesAreMet() (reconciler.OperationResult, error) {
+	if len(a.release.Spec.DependsOn) == 0 || a.release.HasStarted() || a.release.IsDone() {
+		return reconciler.ContinueProcessing()
+	}
+
+	hasCycle, err := a.hasDependencyCycle(a.release, map[string]bool{})
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+	if hasCycle {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonDependencyCycleDetected,
+			"the Release dependencies contain a cycle")
+		return reconciler.StopProcessing()
+	}
+
+	var pendingDependencies []string
+	for _, name := range a.release.Spec.DependsOn {
+		dependency, err := a.loader.GetRelease(a.ctx, a.client, name, a.release.Namespace)
+		if err != nil && !errors.IsNotFound(err) {
+			return reconciler.RequeueWithError(err)
+		}
+
+		if err == nil && dependency.IsDone() && !dependency.HasSucceeded() {
+			a.release.MarkInvalid(v1alpha1.ReleaseReasonDependencyFailed,
+				fmt.Sprintf("the Release %s this Release depends on failed", name))
+			return reconciler.StopProcessing()
+		}
+
+		if err != nil || !dependency.HasSucceeded() {
+			pendingDependencies = append(pendingDependencies, name)
+		}
+	}
+
+	if len(pendingDependencies) > 0 {
+		a.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForDependency,
+			fmt.Sprintf("waiting for Releases to succeed: %s", strings.Join(pendingDependencies, ", ")))
+		return reconciler.RequeueAfter(dependencyRequeueDelay, nil)
+	}
+
+	return reconciler.ContinueProcessing()
+}
+
+// EnsureReleaseIsApproved is an operation that will ensure that the Release being processed was approved by one of its
+// approvers before its release PipelineRun is created. If it wasn't, the Release will be marked as waiting and no
+// further operations will occur for it until the approved-by annotation is set.
+func (a *Adapter) EnsureReleaseIsApproved() (reconciler.OperationResult, error) {
+	if a.release.IsApproved() || a.release.HasStarted() || a.release.IsDone() {
+		return reconciler.ContinueProcessing()
+	}
+
+	a.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForApproval,
+		fmt.Sprintf("waiting for one of the approvers to set the %s annotation: %s",
+			v1alpha1.ApprovedByAnnotation, strings.Join(a.release.Spec.Approvers, ", ")))
+	return reconciler.StopProcessing()
+}
+
+// EnsureReleaseIsNotPaused is an operation that will ensure that the reconciliation of the Release being processed
+// isn't paused by the pause annotation. If it is, the Release will be marked as paused and no further operations will
+// occur for it until the annotation is removed. Releases being deleted are never paused, so the annotation doesn't
+// block their finalization.
+func (a *Adapter) EnsureReleaseIsNotPaused() (reconciler.OperationResult, error) {
+	if isPauseRequested(a.release) && a.release.GetDeletionTimestamp() == nil {
+		if !a.release.IsPaused() {
+			a.logger.Info("Pausing the reconciliation of the Release")
+		}
+		a.release.MarkPaused()
+		return reconciler.StopProcessing()
+	}
+
+	if a.release.IsPaused() {
+		a.logger.Info("Resuming the reconciliation of the Release")
+		a.release.MarkResumed()
+	}
+
+	return reconciler.ContinueProcessing()
+}
+
 // EnsureReleasePlanAdmissionEnabled is an operation that will ensure that the ReleasePlanAdmission is enabled.
 // If it is not, no further operations will occur for this Release.
 func (a *Adapter) EnsureReleasePlanAdmissionEnabled() (reconciler.OperationResult, error) {
-	_, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	_, err := a.getActiveReleasePlanAdmission()
 
 	if err != nil && strings.Contains(err.Error(), "multiple ReleasePlanAdmissions found") {
-		patch := client.MergeFrom(a.release.DeepCopy())
 		a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
-		return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+		return reconciler.StopProcessing()
 	}
 	if err != nil && strings.Contains(err.Error(), "auto-release label set to false") {
-		patch := client.MergeFrom(a.release.DeepCopy())
 		a.release.MarkInvalid(v1alpha1.ReleaseReasonTargetDisabledError, err.Error())
-		return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+		return reconciler.StopProcessing()
+	}
+	if err != nil && strings.Contains(err.Error(), "target namespace") {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonTargetNamespaceNotFound, err.Error())
+		return reconciler.StopProcessing()
+	}
+	return reconciler.ContinueProcessing()
+}
+
+// EnsureReleaseStrategyIsAllowed is an operation that will ensure that a ReleaseStrategy directly referenced by the
+// Release being processed is in one of the shared namespaces allowed in the controller. If it's not, no further
+// operations will occur for this Release. Releases being deleted are ignored, so their finalization isn't blocked.
+func (a *Adapter) EnsureReleaseStrategyIsAllowed() (reconciler.OperationResult, error) {
+	if a.release.Spec.ReleaseStrategy == "" || a.release.IsDone() || a.release.GetDeletionTimestamp() != nil {
+		return reconciler.ContinueProcessing()
+	}
+
+	namespace, _ := getReleaseStrategyReference(a.release)
+	if !isReleaseStrategyNamespaceAllowed(namespace) {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonReleaseStrategyNotAllowed,
+			fmt.Sprintf("ReleaseStrategies from namespace %s are not allowed for this Release", namespace))
+		return reconciler.StopProcessing()
+	}
+
+	return reconciler.ContinueProcessing()
+}
+
+// EnsureTargetOverrideIsAllowed is an operation that will ensure that a Release overriding the target set in its
+// ReleasePlan is allowed to do so. Overrides are only allowed when enabled in the controller and the Release has the
+// override-target label set to true. If the override is not allowed, no further operations will occur for this Release.
+func (a *Adapter) EnsureTargetOverrideIsAllowed() (reconciler.OperationResult, error) {
+	if a.release.Spec.OverrideTarget == "" || a.release.IsDone() || a.release.GetDeletionTimestamp() != nil {
+		return reconciler.ContinueProcessing()
 	}
+
+	if !isTargetOverrideAllowed() {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonTargetOverrideNotAllowed,
+			"target overrides are not allowed in this controller")
+		return reconciler.StopProcessing()
+	}
+
+	if a.release.GetLabels()[v1alpha1.OverrideTargetLabel] != "true" {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonTargetOverrideNotAllowed,
+			fmt.Sprintf("target overrides require the %s label to be set to true", v1alpha1.OverrideTargetLabel))
+		return reconciler.StopProcessing()
+	}
+
+	if !a.release.Status.TargetOverridden {
+		a.logger.Info("Overriding the Release target", "Target", a.release.Spec.OverrideTarget)
+		a.release.Status.TargetOverridden = true
+	}
+
 	return reconciler.ContinueProcessing()
 }
 
+// EnsureReleaseIsValidated is an operation that will ensure that the resources needed to trigger the release
+// PipelineRun of the Release being processed can be resolved before it's triggered. Once they are, the Release is
+// marked as validated and requeued, so the validation is observable before the release PipelineRun is created. New
+// Releases whose ReleasePlan or ReleasePlanAdmission doesn't exist yet are requeued with an increasing delay until they
+// are created or the wait timeout is reached.
+func (a *Adapter) EnsureReleaseIsValidated() (reconciler.OperationResult, error) {
+	if a.release.IsValidated() || a.release.HasStarted() || a.release.IsDone() {
+		return reconciler.ContinueProcessing()
+	}
+
+	releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
+	if err != nil {
+		if isMissingReleasePlanAdmissionError(err) {
+			elapsed := a.clock.Since(a.release.CreationTimestamp.Time)
+			if timeout := getReleasePlanAdmissionWaitTimeout(); elapsed < timeout {
+				a.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForReleasePlanAdmission, err.Error())
+				return reconciler.RequeueAfter(getMissingReleasePlanAdmissionRequeueDelay(elapsed, timeout), nil)
+			}
+		}
+
+		a.recordEvent(corev1.EventTypeWarning, "ReleasePlanAdmissionNotFound",
+			"Unable to find the ReleasePlanAdmission: %s", err.Error())
+		a.release.MarkInvalid(getReleasePlanAdmissionErrorReason(err), err.Error())
+		return reconciler.StopProcessing()
+	}
+
+	if application, found := a.release.GetLabels()[v1alpha1.ApplicationLabel]; found {
+		releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+		if err != nil {
+			a.release.MarkInvalid(getReleasePlanAdmissionErrorReason(err), err.Error())
+			return reconciler.StopProcessing()
+		}
+
+		if releasePlan.Spec.Application != application {
+			a.release.MarkInvalid(v1alpha1.ReleaseReasonApplicationMismatch,
+				fmt.Sprintf("the Release is labeled with application %s but its ReleasePlan %s is for application %s",
+					application, releasePlan.Name, releasePlan.Spec.Application))
+			return reconciler.StopProcessing()
+		}
+	}
+
+	releaseStrategy, err := a.getReleaseStrategy(releasePlanAdmission)
+	if err != nil {
+		a.release.MarkInvalid(getReleaseStrategyErrorReason(a.release, releasePlanAdmission, err), err.Error())
+		return reconciler.StopProcessing()
+	}
+
+	if bundle := getDisallowedBundle(releaseStrategy, a.release.Spec.Collectors); bundle != "" {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonDisallowedBundleRegistry,
+			fmt.Sprintf("the Tekton bundle %s is not hosted in an allowed registry", bundle))
+		return reconciler.StopProcessing()
+	}
+
+	_, err = a.loader.GetEnterpriseContractPolicy(a.ctx, a.client, releaseStrategy)
+	if err != nil {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
+		return reconciler.StopProcessing()
+	}
+
+	unknownTasks, err := a.getUnknownServiceAccountTasks(releaseStrategy)
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+	if len(unknownTasks) > 0 {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError,
+			fmt.Sprintf("the release Pipeline %s has no tasks named %s to set a service account for",
+				releaseStrategy.Spec.Pipeline, strings.Join(unknownTasks, ", ")))
+		return reconciler.StopProcessing()
+	}
+
+	_, err = a.loader.GetSnapshot(a.ctx, a.client, a.release)
+	if err != nil {
+		a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
+		return reconciler.StopProcessing()
+	}
+
+	a.release.MarkValidated()
+
+	return reconciler.Requeue()
+}
+
 // EnsureReleasePipelineRunExists is an operation that will ensure that a release PipelineRun associated to the Release
 // being processed exists. Otherwise, it will create a new release PipelineRun.
 func (a *Adapter) EnsureReleasePipelineRunExists() (reconciler.OperationResult, error) {
+	// Finished Releases never trigger a new release PipelineRun, even if the one they ran no longer exists
+	if a.release.IsDone() {
+		return reconciler.ContinueProcessing()
+	}
+
 	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release)
 	if err != nil && !errors.IsNotFound(err) {
 		return reconciler.RequeueWithError(err)
 	}
 
+	if pipelineRun == nil && a.release.HasStarted() {
+		if isMissingPipelineRunFailureEnabled() {
+			a.release.MarkFailed(v1alpha1.ReleaseReasonPipelineRunMissing,
+				fmt.Sprintf("the release PipelineRun %s no longer exists", a.release.Status.ReleasePipelineRun))
+			return reconciler.StopProcessing()
+		}
+
+		a.logger.Info("The release PipelineRun no longer exists, creating it again",
+			"PipelineRun", a.release.Status.ReleasePipelineRun)
+	}
+
 	if pipelineRun == nil || !a.release.HasStarted() {
-		releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+		releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
 		if err != nil {
-			patch := client.MergeFrom(a.release.DeepCopy())
-			a.release.MarkInvalid(v1alpha1.ReleaseReasonReleasePlanValidationError, err.Error())
-			return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			a.release.MarkInvalid(getReleasePlanAdmissionErrorReason(err), err.Error())
+			return reconciler.StopProcessing()
 		}
 
-		releaseStrategy, err := a.loader.GetReleaseStrategy(a.ctx, a.client, releasePlanAdmission)
+		releaseStrategy, err := a.getReleaseStrategy(releasePlanAdmission)
 		if err != nil {
-			patch := client.MergeFrom(a.release.DeepCopy())
-			a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
-			return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			a.release.MarkInvalid(getReleaseStrategyErrorReason(a.release, releasePlanAdmission, err), err.Error())
+			return reconciler.StopProcessing()
 		}
 
 		enterpriseContractPolicy, err := a.loader.GetEnterpriseContractPolicy(a.ctx, a.client, releaseStrategy)
 		if err != nil {
-			patch := client.MergeFrom(a.release.DeepCopy())
 			a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
-			return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			return reconciler.StopProcessing()
 		}
 
 		snapshot, err := a.loader.GetSnapshot(a.ctx, a.client, a.release)
 		if err != nil {
-			patch := client.MergeFrom(a.release.DeepCopy())
 			a.release.MarkInvalid(v1alpha1.ReleaseReasonValidationError, err.Error())
-			return reconciler.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+			return reconciler.StopProcessing()
 		}
 
 		if pipelineRun == nil {
-			pipelineRun, err = a.createReleasePipelineRun(releaseStrategy, enterpriseContractPolicy, snapshot)
+			pipelineRun = a.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+
+			// The pipeline timeout can already be set by the PipelineRun template of the ReleaseStrategy
+			if pipelineRun.Spec.Timeouts == nil || pipelineRun.Spec.Timeouts.Pipeline == nil {
+				defaultTimeout, err := a.getDefaultReleasePipelineRunTimeout(releaseStrategy)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+				if defaultTimeout > 0 {
+					if pipelineRun.Spec.Timeouts == nil {
+						pipelineRun.Spec.Timeouts = &v1beta1.TimeoutFields{}
+					}
+					pipelineRun.Spec.Timeouts.Pipeline = &metav1.Duration{Duration: defaultTimeout}
+				}
+			}
+
+			paramsSize, maxParamsSize := tekton.GetParamsSize(pipelineRun), getMaxParamsSize()
+			if paramsSize > maxParamsSize {
+				a.release.MarkInvalid(v1alpha1.ReleaseReasonParamsTooLarge,
+					fmt.Sprintf("release PipelineRun params size (%d bytes) exceeds the maximum allowed (%d bytes)",
+						paramsSize, maxParamsSize))
+				return reconciler.StopProcessing()
+			}
+
+			if a.release.IsDryRun() {
+				renderedPipelineRun, err := a.renderReleasePipelineRun(pipelineRun)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+
+				a.release.Status.RenderedPipelineRun = renderedPipelineRun
+				a.release.MarkWaiting(v1alpha1.ReleaseReasonDryRun,
+					"the release PipelineRun was rendered in the Release status without being created")
+				return reconciler.StopProcessing()
+			}
+
+			if isPipelineRunDeduplicationEnabled() {
+				inputsHash := tekton.GetInputsHash(pipelineRun)
+
+				duplicatedPipelineRun, err := a.getDuplicatedReleasePipelineRun(pipelineRun.Namespace, inputsHash)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+
+				if duplicatedPipelineRun != nil {
+					a.logger.Info("Adopting an identical release PipelineRun instead of creating a new one",
+						"PipelineRun.Name", duplicatedPipelineRun.Name, "PipelineRun.Namespace", duplicatedPipelineRun.Namespace)
+					return reconciler.RequeueOnErrorOrContinue(a.registerReleaseStatusData(duplicatedPipelineRun, releaseStrategy))
+				}
+
+				pipelineRun.Labels[tekton.InputsHashLabel] = inputsHash
+			}
+
+			if releaseStrategy.Spec.MinReleaseInterval != nil {
+				remaining, err := a.getRemainingReleaseInterval(releaseStrategy.Spec.MinReleaseInterval.Duration)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+
+				if remaining > 0 {
+					a.release.MarkWaiting(v1alpha1.ReleaseReasonReleaseTooSoon,
+						fmt.Sprintf("the minimum interval between releases (%s) hasn't elapsed yet, %s remaining",
+							releaseStrategy.Spec.MinReleaseInterval.Duration, remaining.Round(time.Second)))
+					return reconciler.RequeueAfter(remaining, nil)
+				}
+			}
+
+			if isQuotaCheckEnabled() {
+				// The check is best-effort, so the PipelineRun is created anyway if the quotas can't be loaded
+				resourceQuotas, err := a.loader.GetResourceQuotas(a.ctx, a.client, pipelineRun.Namespace)
+				if err != nil {
+					a.logger.Error(err, "Failed to load the ResourceQuotas of the target namespace",
+						"Namespace", pipelineRun.Namespace)
+				} else if quotaName, resourceName := getExhaustedQuota(resourceQuotas); quotaName != "" {
+					a.release.MarkWaiting(v1alpha1.ReleaseReasonInsufficientQuota,
+						fmt.Sprintf("ResourceQuota %s in namespace %s has no %s left",
+							quotaName, pipelineRun.Namespace, resourceName))
+					return reconciler.RequeueAfter(insufficientQuotaRequeueDelay, nil)
+				}
+			}
+
+			if maxConcurrent := getMaxConcurrentPipelineRuns(); maxConcurrent > 0 {
+				runningPipelineRuns, err := a.loader.GetRunningReleasePipelineRuns(a.ctx, a.client, pipelineRun.Namespace)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+
+				if len(runningPipelineRuns) >= maxConcurrent {
+					a.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForConcurrencySlot,
+						fmt.Sprintf("%d release PipelineRuns in flight in namespace %s (maximum allowed is %d)",
+							len(runningPipelineRuns), pipelineRun.Namespace, maxConcurrent))
+					return reconciler.RequeueAfter(concurrencySlotRequeueDelay, nil)
+				}
+			}
+
+			if maxPerNamespace := getMaxPipelineRunsPerNamespace(); maxPerNamespace > 0 {
+				managedPipelineRuns, err := a.loader.GetRunningManagedPipelineRuns(a.ctx, a.client, pipelineRun.Namespace)
+				if err != nil {
+					return reconciler.RequeueWithError(err)
+				}
+
+				if len(managedPipelineRuns) >= maxPerNamespace {
+					a.release.MarkWaiting(v1alpha1.ReleaseReasonTargetNamespaceSaturated,
+						fmt.Sprintf("%d PipelineRuns managed by the release service in flight in namespace %s (maximum allowed is %d)",
+							len(managedPipelineRuns), pipelineRun.Namespace, maxPerNamespace))
+					return reconciler.RequeueAfter(concurrencySlotRequeueDelay, nil)
+				}
+			}
+
+			a.recordEvent(corev1.EventTypeNormal, "ReleaseStrategyResolved",
+				"Using ReleaseStrategy %s%c%s", releaseStrategy.Namespace, types.Separator, releaseStrategy.Name)
+			if releaseStrategy.IsDeprecated() {
+				a.recordDeprecatedReleaseStrategyEvent(releaseStrategy)
+			}
+
+			nameTaken, err := a.isReleasePipelineRunNameTaken(pipelineRun)
+			if err != nil {
+				return reconciler.RequeueWithError(err)
+			}
+			if nameTaken {
+				a.logger.Info("The release PipelineRun name is taken by another PipelineRun, generating a random one",
+					"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+				pipelineRun.GenerateName, pipelineRun.Name = releasePipelineRunPrefix+"-", ""
+			}
+
+			err = a.setReleaseAsControllerOwner(pipelineRun)
 			if err != nil {
 				return reconciler.RequeueWithError(err)
 			}
 
+			object, err := tekton.ConvertToAPIVersion(pipelineRun, getTektonAPIVersion())
+			if err != nil {
+				return reconciler.RequeueWithError(err)
+			}
+
+			err = a.applyReleasePipelineRun(object)
+			if err != nil {
+				// Back off instead of hammering an API server that is already rate-limiting requests
+				if errors.IsTooManyRequests(err) {
+					backoff := getPipelineRunCreationBackoff(err)
+					a.logger.Info("The API server is throttling requests, backing off before creating the release PipelineRun",
+						"Backoff", backoff)
+					return reconciler.RequeueAfter(backoff, nil)
+				}
+
+				// Retrying won't help if the PipelineRun itself is rejected, e.g. by an admission webhook
+				if errors.IsInvalid(err) || errors.IsBadRequest(err) {
+					a.release.MarkInvalid(v1alpha1.ReleaseReasonPipelineCreationFailed,
+						fmt.Sprintf("the release PipelineRun was rejected: %s", err.Error()))
+					return reconciler.StopProcessing()
+				}
+
+				return reconciler.RequeueWithError(err)
+			}
+
+			// Keep the metadata set on creation in case the PipelineRun was converted to a different API version or it
+			// was created by a previous reconcile that the cache hadn't observed yet
+			pipelineRun.Name, pipelineRun.UID = object.GetName(), object.GetUID()
+
 			a.logger.Info("Created release PipelineRun",
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordEvent(corev1.EventTypeNormal, "PipelineRunCreated",
+				"Created release PipelineRun %s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+
+			// Persist the reference to the new PipelineRun right away instead of waiting for the end of the reconcile,
+			// so clients can find it from the Release as soon as it exists
+			err = a.registerReleaseStatusData(pipelineRun, releaseStrategy)
+			if err != nil {
+				return reconciler.RequeueWithError(err)
+			}
+
+			return reconciler.RequeueOnErrorOrContinue(a.FlushStatus())
 		}
 
 		return reconciler.RequeueOnErrorOrContinue(a.registerReleaseStatusData(pipelineRun, releaseStrategy))
@@ -202,12 +708,82 @@ func (a *Adapter) EnsureReleasePipelineStatusIsTracked() (reconciler.OperationRe
 		return reconciler.RequeueWithError(err)
 	}
 	if pipelineRun != nil {
-		return reconciler.RequeueOnErrorOrContinue(a.registerReleasePipelineRunStatus(pipelineRun))
+		a.registerReleasePipelineRunTampering(pipelineRun)
+		err = a.registerReleasePipelineRunStatus(pipelineRun)
+		if err == nil && !pipelineRun.IsDone() {
+			storagePending, storageErr := a.registerWorkspaceStorageStatus(pipelineRun)
+			if storageErr != nil {
+				return reconciler.RequeueWithError(storageErr)
+			}
+
+			// PersistentVolumeClaims are not watched, so poll until the storage is provisioned
+			if storagePending {
+				return reconciler.RequeueAfter(workspaceStoragePollInterval, nil)
+			}
+		}
+
+		if err == nil && len(pipelineRun.Status.Conditions) == 0 {
+			// The PipelineRun was just created and Tekton hasn't reported its status yet, so poll until it's observable
+			return reconciler.RequeueAfter(getPipelineRunStatusPollInterval(), nil)
+		}
+
+		if err == nil && !pipelineRun.IsDone() {
+			// Check again later in case the deletion of the PipelineRun is missed, so the Release doesn't get stuck
+			return reconciler.RequeueAfter(getMissingPipelineRunCheckInterval(), nil)
+		}
+
+		return reconciler.RequeueOnErrorOrContinue(err)
 	}
 
 	return reconciler.ContinueProcessing()
 }
 
+// EnsureReleaseProvenanceIsVerified is an operation that will ensure that, when the ReleaseStrategy requires it, the
+// release PipelineRun of a successful Release is signed by Tekton Chains within the configured grace period. If the
+// provenance is missing, the Release will be marked accordingly and it won't be processed any further.
+func (a *Adapter) EnsureReleaseProvenanceIsVerified() (reconciler.OperationResult, error) {
+	if !a.release.HasSucceeded() || a.release.IsProvenanceVerified() {
+		return reconciler.ContinueProcessing()
+	}
+
+	if a.release.IsProvenanceChecked() {
+		return reconciler.StopProcessing()
+	}
+
+	releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+
+	releaseStrategy, err := a.getReleaseStrategy(releasePlanAdmission)
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+
+	if releaseStrategy.Spec.ProvenanceGracePeriod == nil {
+		return reconciler.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconciler.RequeueWithError(err)
+	}
+
+	if pipelineRun != nil && tekton.HasChainsProvenance(pipelineRun) {
+		a.release.MarkProvenanceVerified()
+		return reconciler.ContinueProcessing()
+	}
+
+	gracePeriod := releaseStrategy.Spec.ProvenanceGracePeriod.Duration
+	if remaining := a.release.Status.CompletionTime.Add(gracePeriod).Sub(a.clock.Now()); remaining > 0 {
+		return reconciler.RequeueAfter(remaining, nil)
+	}
+
+	a.release.MarkProvenanceMissing(fmt.Sprintf("the release PipelineRun wasn't signed by Tekton Chains within %s",
+		gracePeriod))
+	return reconciler.StopProcessing()
+}
+
 // EnsureSnapshotEnvironmentBindingExists is an operation that will ensure that a SnapshotEnvironmentBinding
 // associated to the Release being processed exists. Otherwise, it will create a new one.
 func (a *Adapter) EnsureSnapshotEnvironmentBindingExists() (reconciler.OperationResult, error) {
@@ -215,7 +791,7 @@ func (a *Adapter) EnsureSnapshotEnvironmentBindingExists() (reconciler.Operation
 		return reconciler.ContinueProcessing()
 	}
 
-	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
 	if err != nil {
 		return reconciler.RequeueWithError(err)
 	}
@@ -238,10 +814,9 @@ func (a *Adapter) EnsureSnapshotEnvironmentBindingExists() (reconciler.Operation
 	a.logger.Info("Created/updated SnapshotEnvironmentBinding",
 		"SnapshotEnvironmentBinding.Name", binding.Name, "SnapshotEnvironmentBinding.Namespace", binding.Namespace)
 
-	patch := client.MergeFrom(a.release.DeepCopy())
 	a.release.Status.SnapshotEnvironmentBinding = fmt.Sprintf("%s%c%s", binding.Namespace, types.Separator, binding.Name)
 
-	return reconciler.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	return reconciler.ContinueProcessing()
 }
 
 // EnsureSnapshotEnvironmentBindingIsTracked is an operation that will ensure that the SnapshotEnvironmentBinding
@@ -263,43 +838,248 @@ func (a *Adapter) EnsureSnapshotEnvironmentBindingIsTracked() (reconciler.Operat
 		return reconciler.ContinueProcessing()
 	}
 
-	return reconciler.RequeueOnErrorOrContinue(a.registerGitOpsDeploymentStatus(binding))
+	a.registerGitOpsDeploymentStatus(binding)
+
+	return reconciler.ContinueProcessing()
 }
 
-// createReleasePipelineRun creates and returns a new release PipelineRun. The new PipelineRun will include owner
-// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
-// will be extracted from the given ReleaseStrategy. The Release's Snapshot will also be passed to the release
-// PipelineRun.
-func (a *Adapter) createReleasePipelineRun(releaseStrategy *v1alpha1.ReleaseStrategy,
-	enterpriseContractPolicy *ecapiv1alpha1.EnterpriseContractPolicy,
-	snapshot *applicationapiv1alpha1.Snapshot) (*v1beta1.PipelineRun, error) {
-	pipelineRun := tekton.NewReleasePipelineRun("release-pipelinerun", releaseStrategy.Namespace).
-		WithOwner(a.release).
-		WithReleaseAndApplicationMetadata(a.release, snapshot.Spec.Application).
-		WithReleaseStrategy(releaseStrategy).
-		WithEnterpriseContractPolicy(enterpriseContractPolicy).
-		WithSnapshot(snapshot).
-		AsPipelineRun()
+// EnsureReleaseOutcomeIsNotified is an operation that will ensure that the outcome of the Release being processed is
+// notified to the webhooks declared in its ReleaseStrategy and to its notification channels once its release
+// PipelineRun finishes. A slot is reserved in the notification queue and then the Release is marked as notified and
+// its status persisted before the notification is dispatched, so it's never notified twice nor dropped. The
+// notification is sent in the background, so the reconcile doesn't wait for the endpoints to respond. If the queue is
+// full, the Release is requeued.
+func (a *Adapter) EnsureReleaseOutcomeIsNotified() (reconciler.OperationResult, error) {
+	if a.dispatcher == nil || !a.release.HasStarted() || !a.release.IsDone() || a.release.Status.Notified {
+		return reconciler.ContinueProcessing()
+	}
 
-	err := a.client.Create(a.ctx, pipelineRun)
-	if err != nil {
-		return nil, err
+	if !a.dispatcher.Reserve() {
+		a.logger.Info("The notification queue is full, retrying the Release notification later")
+		return reconciler.RequeueAfter(notificationQueueFullRequeueDelay, nil)
 	}
 
-	return pipelineRun, nil
-}
+	webhooks := a.getReleaseWebhooks()
 
-// createSnapshotEnvironmentBinding creates or updates a SnapshotEnvironmentBinding for the Release being processed.
-func (a *Adapter) createOrUpdateSnapshotEnvironmentBinding(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error) {
-	resources, err := a.loader.GetSnapshotEnvironmentBindingResources(a.ctx, a.client, a.release, releasePlanAdmission)
+	a.release.Status.Notified = true
+	err := a.FlushStatus()
 	if err != nil {
-		return nil, err
+		a.dispatcher.CancelReservation()
+		a.release.Status.Notified = false
+		return reconciler.RequeueWithError(err)
 	}
 
-	// The binding information needs to be updated no matter if it already exists or not
-	binding := gitops.NewSnapshotEnvironmentBinding(resources.ApplicationComponents, resources.Snapshot, resources.Environment)
+	a.dispatcher.Dispatch(a.release, webhooks)
 
-	// Search for an existing binding
+	return reconciler.ContinueProcessing()
+}
+
+// EnsureCollectorsPipelineRunExists is an operation that will ensure that a PipelineRun running the collectors of the
+// Release being processed exists once the Release succeeds. Otherwise, it will create a new one in the Release
+// namespace, as the collectors are declared by the tenant and can't run with the privileges of the managed namespace.
+func (a *Adapter) EnsureCollectorsPipelineRunExists() (reconciler.OperationResult, error) {
+	if !a.release.HasSucceeded() || len(a.release.Spec.Collectors) == 0 || a.release.Status.CollectorsPipelineRun != "" {
+		return reconciler.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetCollectorsPipelineRun(a.ctx, a.client, a.release)
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+
+	if pipelineRun == nil {
+		releasePipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release)
+		if err != nil {
+			return reconciler.RequeueWithError(err)
+		}
+
+		a.release.MarkCollectingMetadata()
+		if releasePipelineRun == nil {
+			a.release.MarkMetadataCollectionFailed("the release PipelineRun no longer exists")
+			return reconciler.ContinueProcessing()
+		}
+
+		if bundle := getDisallowedCollectorBundle(a.release.Spec.Collectors); bundle != "" {
+			a.release.MarkMetadataCollectionFailed(
+				fmt.Sprintf("the Tekton bundle %s is not hosted in an allowed registry", bundle))
+			return reconciler.ContinueProcessing()
+		}
+
+		pipelineRun = a.newCollectorsPipelineRun(releasePipelineRun)
+		if fieldErr := pipelineRun.Spec.PipelineSpec.Validate(a.ctx); fieldErr != nil {
+			a.release.MarkMetadataCollectionFailed(
+				fmt.Sprintf("the collectors PipelineRun is not valid: %s", fieldErr.Error()))
+			return reconciler.ContinueProcessing()
+		}
+
+		err = a.setReleaseAsControllerOwner(pipelineRun)
+		if err != nil {
+			return reconciler.RequeueWithError(err)
+		}
+
+		object, err := tekton.ConvertToAPIVersion(pipelineRun, getTektonAPIVersion())
+		if err != nil {
+			return reconciler.RequeueWithError(err)
+		}
+
+		err = a.client.Create(a.ctx, object)
+		if err != nil {
+			// Retrying won't help if the PipelineRun itself is rejected, e.g. by an admission webhook
+			if errors.IsInvalid(err) || errors.IsBadRequest(err) {
+				a.release.MarkMetadataCollectionFailed(
+					fmt.Sprintf("the collectors PipelineRun was rejected: %s", err.Error()))
+				return reconciler.ContinueProcessing()
+			}
+
+			return reconciler.RequeueWithError(err)
+		}
+		pipelineRun.Name = object.GetName()
+
+		a.logger.Info("Created collectors PipelineRun",
+			"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+	}
+
+	a.release.Status.CollectorsPipelineRun = fmt.Sprintf("%s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+	a.release.MarkCollectingMetadata()
+
+	return reconciler.ContinueProcessing()
+}
+
+// EnsureCollectorsPipelineRunIsTracked is an operation that will ensure that the status of the collectors PipelineRun
+// is tracked in the Release being processed. Once the PipelineRun succeeds, the results of the collectors are recorded
+// in the Release status.
+func (a *Adapter) EnsureCollectorsPipelineRunIsTracked() (reconciler.OperationResult, error) {
+	if !a.release.IsCollectingMetadata() {
+		return reconciler.ContinueProcessing()
+	}
+
+	pipelineRun, err := a.loader.GetCollectorsPipelineRun(a.ctx, a.client, a.release)
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+
+	if pipelineRun == nil {
+		a.release.MarkMetadataCollectionFailed("the collectors PipelineRun no longer exists")
+		return reconciler.ContinueProcessing()
+	}
+
+	if !pipelineRun.IsDone() {
+		return reconciler.ContinueProcessing()
+	}
+
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if !condition.IsTrue() {
+		a.release.MarkMetadataCollectionFailed(condition.Message)
+		return reconciler.ContinueProcessing()
+	}
+
+	taskRuns, err := a.loader.GetReleasePipelineRunTaskRuns(a.ctx, a.client, pipelineRun)
+	if err != nil {
+		return reconciler.RequeueWithError(err)
+	}
+
+	a.release.Status.CollectorResults = getCollectorResults(taskRuns)
+	a.release.MarkMetadataCollected()
+
+	return reconciler.ContinueProcessing()
+}
+
+// newCollectorsPipelineRun returns a new collectors PipelineRun ready to be created. The new PipelineRun will include
+// owner annotations, so it triggers Release reconciles once it finishes. It runs in the Release namespace with the
+// default service account of that namespace, and it's labeled with the application of the given release PipelineRun.
+func (a *Adapter) newCollectorsPipelineRun(releasePipelineRun *v1beta1.PipelineRun) *v1beta1.PipelineRun {
+	return tekton.NewReleasePipelineRun("collectors-pipelinerun", a.release.Namespace).
+		WithOwner(a.release).
+		WithReleaseAndApplicationMetadata(a.release, releasePipelineRun.Labels[tekton.ApplicationNameLabel]).
+		WithCollectors(a.release.Spec.Collectors).
+		AsPipelineRun()
+}
+
+// newReleasePipelineRun returns a new release PipelineRun ready to be created in the namespace of the given
+// ReleasePlanAdmission, even if the ReleaseStrategy lives in another namespace. The new PipelineRun will include owner
+// annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
+// will be extracted from the given ReleaseStrategy. The Release's Snapshot will also be passed to the release
+// PipelineRun.
+func (a *Adapter) newReleasePipelineRun(releasePlanAdmission *v1alpha1.ReleasePlanAdmission,
+	releaseStrategy *v1alpha1.ReleaseStrategy, enterpriseContractPolicy *ecapiv1alpha1.EnterpriseContractPolicy,
+	snapshot *applicationapiv1alpha1.Snapshot) *v1beta1.PipelineRun {
+	pipelineRun := tekton.NewReleasePipelineRun(releasePipelineRunPrefix, releasePlanAdmission.Namespace).
+		WithOwner(a.release).
+		WithReleaseAndApplicationMetadata(a.release, snapshot.Spec.Application).
+		WithPropagatedMetadata(a.release, getPropagatedMetadataPrefixes()).
+		WithReleaseStrategy(releaseStrategy).
+		WithEnv(a.release.Spec.Env).
+		WithTaskServiceAccounts(releaseStrategy.Spec.PipelineServiceAccountPerTask).
+		WithEnterpriseContractPolicy(enterpriseContractPolicy).
+		WithSnapshot(snapshot).
+		WithReleaseParam(a.release).
+		AsPipelineRun()
+
+	if name := getReleasePipelineRunName(a.release); name != "" {
+		pipelineRun.GenerateName, pipelineRun.Name = "", name
+	}
+
+	return pipelineRun
+}
+
+// applyReleasePipelineRun creates the given release PipelineRun using a server-side apply, so repeated reconciles
+// converge on the same PipelineRun instead of conflicting with the one created by a previous reconcile that isn't
+// observable yet. Conflicts with other field managers are resolved by forcing the ownership of the fields set by the
+// release service. PipelineRuns without a name can't be applied, so they are created instead.
+func (a *Adapter) applyReleasePipelineRun(object client.Object) error {
+	if object.GetName() == "" {
+		return a.client.Create(a.ctx, object)
+	}
+
+	gvk, err := apiutil.GVKForObject(object, a.client.Scheme())
+	if err != nil {
+		return err
+	}
+	object.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return a.client.Patch(a.ctx, object, client.Apply, client.FieldOwner(pipelineRunFieldManager), client.ForceOwnership)
+}
+
+// renderReleasePipelineRun returns the YAML representation of the given release PipelineRun as it would be created,
+// including its controller owner reference and converted to the Tekton API version set in the controller.
+func (a *Adapter) renderReleasePipelineRun(pipelineRun *v1beta1.PipelineRun) (string, error) {
+	pipelineRun = pipelineRun.DeepCopy()
+
+	err := a.setReleaseAsControllerOwner(pipelineRun)
+	if err != nil {
+		return "", err
+	}
+
+	object, err := tekton.ConvertToAPIVersion(pipelineRun, getTektonAPIVersion())
+	if err != nil {
+		return "", err
+	}
+
+	gvk, err := apiutil.GVKForObject(object, a.client.Scheme())
+	if err != nil {
+		return "", err
+	}
+	object.GetObjectKind().SetGroupVersionKind(gvk)
+
+	renderedPipelineRun, err := yaml.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(renderedPipelineRun), nil
+}
+
+// createSnapshotEnvironmentBinding creates or updates a SnapshotEnvironmentBinding for the Release being processed.
+func (a *Adapter) createOrUpdateSnapshotEnvironmentBinding(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error) {
+	resources, err := a.loader.GetSnapshotEnvironmentBindingResources(a.ctx, a.client, a.release, releasePlanAdmission)
+	if err != nil {
+		return nil, err
+	}
+
+	// The binding information needs to be updated no matter if it already exists or not
+	binding := gitops.NewSnapshotEnvironmentBinding(resources.ApplicationComponents, resources.Snapshot, resources.Environment)
+
+	// Search for an existing binding
 	existingBinding, err := a.loader.GetSnapshotEnvironmentBinding(a.ctx, a.client, releasePlanAdmission)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, err
@@ -343,61 +1123,547 @@ func (a *Adapter) finalizeRelease() error {
 		return err
 	}
 
-	if pipelineRun != nil {
+	// Adopted PipelineRuns belong to another Release, so they are not deleted
+	if pipelineRun != nil && pipelineRun.Labels[tekton.ReleaseNameLabel] == a.release.Name &&
+		pipelineRun.Labels[tekton.ReleaseNamespaceLabel] == a.release.Namespace {
 		err = a.client.Delete(a.ctx, pipelineRun)
 		if err != nil && !errors.IsNotFound(err) {
 			return err
 		}
 	}
 
+	collectorsPipelineRun, err := a.loader.GetCollectorsPipelineRun(a.ctx, a.client, a.release)
+	if err != nil {
+		return err
+	}
+
+	if collectorsPipelineRun != nil {
+		err = a.client.Delete(a.ctx, collectorsPipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
 	a.logger.Info("Successfully finalized Release")
 
 	return nil
 }
 
+// isReleasePipelineRunNameTaken returns true if a PipelineRun with the name of the given release PipelineRun already
+// exists and it doesn't belong to the Release being processed, in which case applying it would take over that
+// PipelineRun. PipelineRuns without a name can't collide with an existing one, so false is returned for them.
+func (a *Adapter) isReleasePipelineRunNameTaken(pipelineRun *v1beta1.PipelineRun) (bool, error) {
+	if pipelineRun.Name == "" {
+		return false, nil
+	}
+
+	existingPipelineRun := &v1beta1.PipelineRun{}
+	err := a.client.Get(a.ctx, client.ObjectKeyFromObject(pipelineRun), existingPipelineRun)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return existingPipelineRun.GetAnnotations()[libhandler.NamespacedNameAnnotation] !=
+		pipelineRun.GetAnnotations()[libhandler.NamespacedNameAnnotation], nil
+}
+
+// getDuplicatedReleasePipelineRun returns the release PipelineRun running in the given namespace whose inputs have the
+// given hash. If no running release PipelineRun has the same inputs, nil will be returned.
+func (a *Adapter) getDuplicatedReleasePipelineRun(namespace, inputsHash string) (*v1beta1.PipelineRun, error) {
+	runningPipelineRuns, err := a.loader.GetRunningReleasePipelineRuns(a.ctx, a.client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range runningPipelineRuns {
+		if runningPipelineRuns[i].Labels[tekton.InputsHashLabel] == inputsHash {
+			return &runningPipelineRuns[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getUnknownServiceAccountTasks returns the names of the tasks the given ReleaseStrategy sets a service account for
+// that don't exist in the release Pipeline it references. Pipelines stored in bundles or not found in the cluster
+// can't be resolved beforehand, so no tasks are returned for them.
+func (a *Adapter) getUnknownServiceAccountTasks(releaseStrategy *v1alpha1.ReleaseStrategy) ([]string, error) {
+	if len(releaseStrategy.Spec.PipelineServiceAccountPerTask) == 0 || releaseStrategy.Spec.Bundle != "" {
+		return nil, nil
+	}
+
+	pipeline, err := a.loader.GetReleasePipeline(a.ctx, a.client, releaseStrategy)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	return getUnknownPipelineTasks(pipeline, releaseStrategy.Spec.PipelineServiceAccountPerTask), nil
+}
+
+// getDefaultReleasePipelineRunTimeout returns the default timeout to set on the release PipelineRun of the given
+// ReleaseStrategy. The default is only applied when the run would otherwise be unbounded, so zero is returned if no
+// default is configured, the ReleaseStrategy sets its own timeout or every task of the release Pipeline sets one.
+// Pipelines stored in bundles or not found in the cluster can't be resolved beforehand, so the default is applied.
+func (a *Adapter) getDefaultReleasePipelineRunTimeout(releaseStrategy *v1alpha1.ReleaseStrategy) (time.Duration, error) {
+	timeout := getDefaultPipelineRunTimeout()
+	if timeout <= 0 || releaseStrategy.Spec.Timeout != nil {
+		return 0, nil
+	}
+
+	if releaseStrategy.Spec.Bundle == "" {
+		pipeline, err := a.loader.GetReleasePipeline(a.ctx, a.client, releaseStrategy)
+		if err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+
+		if err == nil && isPipelineBounded(pipeline) {
+			return 0, nil
+		}
+	}
+
+	return timeout, nil
+}
+
+// getRemainingPipelineRunRetention returns how long the given release PipelineRun has to be kept before it can be
+// deleted. Only failed PipelineRuns are retained, for the period returned by getFailedPipelineRunRetention counted from
+// their completion, so successful PipelineRuns can be deleted straight away.
+func (a *Adapter) getRemainingPipelineRunRetention(pipelineRun *v1beta1.PipelineRun) time.Duration {
+	retention := getFailedPipelineRunRetention()
+	if retention <= 0 || pipelineRun == nil || !pipelineRun.IsDone() ||
+		pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+		return 0
+	}
+
+	completionTime := pipelineRun.Status.CompletionTime
+	if completionTime == nil {
+		completionTime = a.release.Status.CompletionTime
+	}
+	if completionTime == nil {
+		return 0
+	}
+
+	return completionTime.Add(retention).Sub(a.clock.Now())
+}
+
+// getRemainingReleaseInterval returns how long the Release being processed has to wait until the given minimum
+// interval since the completion of the previous successful Release of the same application elapses. If there is no
+// previous successful Release or the interval has already elapsed, zero will be returned.
+func (a *Adapter) getRemainingReleaseInterval(minInterval time.Duration) (time.Duration, error) {
+	previousRelease, err := a.loader.GetPreviousSuccessfulRelease(a.ctx, a.client, a.release)
+	if err != nil || previousRelease == nil || previousRelease.Status.CompletionTime == nil {
+		return 0, err
+	}
+
+	remaining := previousRelease.Status.CompletionTime.Add(minInterval).Sub(a.clock.Now())
+	if remaining < 0 {
+		return 0, nil
+	}
+
+	return remaining, nil
+}
+
+// getActiveReleasePlanAdmission returns the active ReleasePlanAdmission targeted by the ReleasePlan of the Release
+// being processed. If none is found in the ReleasePlan target and the target fallback is enabled, the
+// ReleasePlanAdmission accepting releases of the same application is used instead and the relaxed match is reported
+// in the Release status. Releases overriding their target never fall back.
+func (a *Adapter) getActiveReleasePlanAdmission() (*v1alpha1.ReleasePlanAdmission, error) {
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err == nil || !isTargetFallbackEnabled() || a.release.Spec.OverrideTarget != "" ||
+		!(strings.Contains(err.Error(), "no ReleasePlanAdmission found") ||
+			strings.Contains(err.Error(), "target namespace")) {
+		return releasePlanAdmission, err
+	}
+
+	releasePlan, planErr := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if planErr != nil {
+		return nil, err
+	}
+
+	releasePlanAdmission, fallbackErr := a.loader.GetReleasePlanAdmissionByApplication(a.ctx, a.client, releasePlan)
+	if fallbackErr != nil {
+		return nil, err
+	}
+
+	if !a.release.IsMatchedViaFallback() {
+		a.logger.Info("ReleasePlanAdmission found by matching the application alone",
+			"ReleasePlanAdmission.Name", releasePlanAdmission.Name,
+			"ReleasePlanAdmission.Namespace", releasePlanAdmission.Namespace)
+		a.release.MarkMatchedViaFallback(fmt.Sprintf("no ReleasePlanAdmission found in the target %s, using %s%c%s",
+			releasePlan.Spec.Target, releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name))
+	}
+
+	return releasePlanAdmission, nil
+}
+
+// getReleaseStrategy returns the ReleaseStrategy to use for the Release being processed. That is the one referenced in
+// the Release spec.releaseStrategy if set or, otherwise, the one referenced by the given ReleasePlanAdmission. If the
+// ReleaseStrategy doesn't exist, the returned error names the resource referencing it.
+func (a *Adapter) getReleaseStrategy(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseStrategy, error) {
+	if usesReleaseStrategyArtifact(a.release, releasePlanAdmission) {
+		return a.getReleaseStrategyFromArtifact(releasePlanAdmission)
+	}
+
+	if a.release.Spec.ReleaseStrategy == "" {
+		releaseStrategy, err := a.loader.GetReleaseStrategy(a.ctx, a.client, releasePlanAdmission)
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("ReleasePlanAdmission %s%c%s references missing ReleaseStrategy %s%c%s: %w",
+				releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name,
+				releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Spec.ReleaseStrategy, err)
+		}
+
+		return releaseStrategy, err
+	}
+
+	namespace, name := getReleaseStrategyReference(a.release)
+
+	releaseStrategy, err := a.loader.GetReleaseStrategyByName(a.ctx, a.client, name, namespace)
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("Release %s%c%s references missing ReleaseStrategy %s%c%s: %w",
+			a.release.Namespace, types.Separator, a.release.Name, namespace, types.Separator, name, err)
+	}
+
+	return releaseStrategy, err
+}
+
+// getReleaseStrategyFromArtifact returns the ReleaseStrategy stored in the OCI artifact referenced by the given
+// ReleasePlanAdmission. The ReleaseStrategy is considered to live in the ReleasePlanAdmission namespace.
+func (a *Adapter) getReleaseStrategyFromArtifact(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseStrategy, error) {
+	if a.strategyResolver == nil {
+		return nil, fmt.Errorf("ReleaseStrategy artifacts are not supported by this controller")
+	}
+
+	releaseStrategy, err := a.strategyResolver.Resolve(a.ctx, releasePlanAdmission.Spec.ReleaseStrategyArtifact)
+	if err != nil {
+		return nil, err
+	}
+	releaseStrategy.Namespace = releasePlanAdmission.Namespace
+
+	return releaseStrategy, nil
+}
+
+// hasDependencyCycle walks the dependencies of the given Release and returns true if any of them leads back to a
+// Release already in the current path. Dependencies that don't exist yet are skipped, as they can't be part of a cycle.
+func (a *Adapter) hasDependencyCycle(release *v1alpha1.Release, path map[string]bool) (bool, error) {
+	path[release.Name] = true
+	defer delete(path, release.Name)
+
+	for _, name := range release.Spec.DependsOn {
+		if path[name] {
+			return true, nil
+		}
+
+		dependency, err := a.loader.GetRelease(a.ctx, a.client, name, release.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+
+		hasCycle, err := a.hasDependencyCycle(dependency, path)
+		if err != nil || hasCycle {
+			return hasCycle, err
+		}
+	}
+
+	return false, nil
+}
+
+// patchRelease applies the given patch to the Release being processed. As the response of the patch would overwrite
+// them, the status changes that are still pending to be written by FlushStatus are preserved.
+func (a *Adapter) patchRelease(patch client.Patch) error {
+	status := a.release.Status.DeepCopy()
+	err := a.client.Patch(a.ctx, a.release, patch)
+	a.release.Status = *status
+
+	return err
+}
+
 // registerGitOpsDeploymentStatus updates the status of the Release being processed by monitoring the status of the
 // associated SnapshotEnvironmentBinding and setting the appropriate state in the Release.
-func (a *Adapter) registerGitOpsDeploymentStatus(binding *applicationapiv1alpha1.SnapshotEnvironmentBinding) error {
+func (a *Adapter) registerGitOpsDeploymentStatus(binding *applicationapiv1alpha1.SnapshotEnvironmentBinding) {
 	if binding == nil {
-		return nil
+		return
 	}
 
 	condition := meta.FindStatusCondition(binding.Status.ComponentDeploymentConditions,
 		applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed)
 	if condition == nil {
-		return nil
+		return
 	}
 
-	patch := client.MergeFrom(a.release.DeepCopy())
-
 	if condition.Status == metav1.ConditionTrue {
 		a.release.MarkDeployed(condition.Reason, condition.Message)
 	} else {
 		a.release.MarkDeploying(condition.Status, condition.Reason, condition.Message)
 	}
+}
+
+// registerReleaseOutcomeLabel stamps the outcome of the Release being processed in its outcome label, so finished
+// Releases can be filtered with label selectors. The label is updated if the outcome changes and removed if the Release
+// is no longer done. If the Release no longer exists, nothing will be written.
+func (a *Adapter) registerReleaseOutcomeLabel() error {
+	outcome := ""
+	if a.release.IsDone() {
+		outcome = v1alpha1.OutcomeLabelFailed
+		if a.release.HasSucceeded() {
+			outcome = v1alpha1.OutcomeLabelSucceeded
+		}
+	}
+
+	if a.release.GetLabels()[v1alpha1.OutcomeLabel] == outcome {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	labels := a.release.GetLabels()
+	if outcome == "" {
+		delete(labels, v1alpha1.OutcomeLabel)
+	} else {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[v1alpha1.OutcomeLabel] = outcome
+	}
+	a.release.SetLabels(labels)
+
+	return client.IgnoreNotFound(a.patchRelease(patch))
+}
+
+// registerReleasePipelineRunReason records the reason of the Succeeded condition of the given release PipelineRun in
+// the reason history of the Release being processed, timestamped with the last transition of the condition. Nothing
+// is recorded until the PipelineRun reports the condition.
+func (a *Adapter) registerReleasePipelineRunReason(pipelineRun *v1beta1.PipelineRun) {
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil || condition.Reason == "" {
+		return
+	}
+
+	transitionTime := condition.LastTransitionTime.Inner
+	if transitionTime.IsZero() {
+		transitionTime = metav1.Time{Time: a.clock.Now()}
+	}
+
+	a.release.Status.ReasonHistory = addReasonTransition(a.release.Status.ReasonHistory, v1alpha1.ReasonTransition{
+		Reason: condition.Reason,
+		Time:   transitionTime,
+	})
+}
+
+// registerReleasePipelineRunResultLabels stamps the results of the given release PipelineRun as labels in the Release
+// being processed, following the mapping declared in its spec.labelsToResults. Result values are sanitized so they
+// are valid label values. Results that are not found or are not strings are skipped.
+func (a *Adapter) registerReleasePipelineRunResultLabels(pipelineRun *v1beta1.PipelineRun) error {
+	if len(a.release.Spec.LabelsToResults) == 0 {
+		return nil
+	}
+
+	results := make(map[string]string, len(pipelineRun.Status.PipelineResults))
+	for _, result := range pipelineRun.Status.PipelineResults {
+		if result.Value.StringVal != "" {
+			results[result.Name] = result.Value.StringVal
+		}
+	}
+
+	labels := make(map[string]string)
+	for label, resultName := range a.release.Spec.LabelsToResults {
+		if value, found := results[resultName]; found {
+			labels[label] = metadata.SanitizeLabelValue(value)
+		}
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	metadata.AddLabels(a.release, labels)
+
+	return a.patchRelease(patch)
+}
+
+// registerReleasePipelineRunResultsOutput writes the results of the given release PipelineRun to the Secret or
+// ConfigMap declared in the spec.resultsOutput of the Release being processed. The Release is set as the controller
+// owner of the resource, so it's garbage collected along with the Release. A resource that already exists is only
+// replaced if it's controlled by the Release. Otherwise, it's left untouched and false is returned.
+func (a *Adapter) registerReleasePipelineRunResultsOutput(pipelineRun *v1beta1.PipelineRun) (bool, error) {
+	resultsOutput := a.release.Spec.ResultsOutput
+	if resultsOutput == nil {
+		return true, nil
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      resultsOutput.Name,
+		Namespace: a.release.Namespace,
+	}
+	results := getPipelineRunResults(pipelineRun)
+
+	var output, existing client.Object
+	switch resultsOutput.Kind {
+	case v1alpha1.ResultsOutputKindConfigMap:
+		output = &corev1.ConfigMap{ObjectMeta: objectMeta, Data: results}
+		existing = &corev1.ConfigMap{}
+	case v1alpha1.ResultsOutputKindSecret:
+		secret := &corev1.Secret{ObjectMeta: objectMeta, Type: corev1.SecretTypeOpaque, Data: map[string][]byte{}}
+		for name, value := range results {
+			secret.Data[name] = []byte(value)
+		}
+		output = secret
+		existing = &corev1.Secret{}
+	default:
+		return false, fmt.Errorf("unsupported resultsOutput kind %s", resultsOutput.Kind)
+	}
+
+	err := ctrl.SetControllerReference(a.release, output, a.client.Scheme())
+	if err != nil {
+		return false, err
+	}
+
+	err = a.client.Create(a.ctx, output)
+	if errors.IsAlreadyExists(err) {
+		err = a.client.Get(a.ctx, client.ObjectKeyFromObject(output), existing)
+		if err != nil {
+			return false, err
+		}
+
+		if !metav1.IsControlledBy(existing, a.release) {
+			a.logger.Info("The resultsOutput resource isn't owned by the Release, leaving it untouched",
+				"Kind", resultsOutput.Kind, "Name", resultsOutput.Name, "Namespace", a.release.Namespace)
+			return false, nil
+		}
+
+		output.SetResourceVersion(existing.GetResourceVersion())
+		err = a.client.Update(a.ctx, output)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	a.logger.Info("Wrote the release PipelineRun results",
+		"Kind", resultsOutput.Kind, "Name", resultsOutput.Name, "Namespace", a.release.Namespace)
+
+	return true, nil
+}
+
+// registerReleasePipelineRunSpecStatus updates the status of the Release being processed to reflect the spec.status of
+// the associated release PipelineRun, which is set when the PipelineRun is externally paused or cancelled. The
+// PipelineRun is never modified, so the intent of whoever changed it is respected.
+func (a *Adapter) registerReleasePipelineRunSpecStatus(pipelineRun *v1beta1.PipelineRun) {
+	switch {
+	case pipelineRun.IsPending():
+		a.release.MarkProgressing(v1alpha1.ReleaseReasonPipelinePaused, "the release PipelineRun was paused externally")
+	case isPipelineRunCancelled(pipelineRun):
+		a.release.MarkProgressing(v1alpha1.ReleaseReasonPipelineCancelling,
+			fmt.Sprintf("the release PipelineRun is being cancelled externally (%s)", pipelineRun.Spec.Status))
+	default:
+		a.release.MarkProgressing(v1alpha1.ReleaseReasonRunning, tekton.GetStatusMessage(pipelineRun))
+	}
+}
+
+// registerReleasePipelineRunFailureLog stores an excerpt of the logs of the failed step of the given release
+// PipelineRun in the status of the Release being processed. Capturing the logs is best effort, so any error found
+// while fetching them is logged and the Release status is left untouched.
+func (a *Adapter) registerReleasePipelineRunFailureLog(pipelineRun *v1beta1.PipelineRun) {
+	tailLines := getFailureLogLines()
+	if a.logSource == nil || tailLines <= 0 || a.release.Status.FailureLog != "" {
+		return
+	}
+
+	taskRuns, err := a.loader.GetReleasePipelineRunTaskRuns(a.ctx, a.client, pipelineRun)
+	if err != nil {
+		a.logger.Error(err, "Unable to get the release PipelineRun TaskRuns")
+		return
+	}
 
-	return a.client.Status().Patch(a.ctx, a.release, patch)
+	podName, containerName, found := tekton.GetFailedStep(taskRuns)
+	if !found {
+		return
+	}
+
+	logs, err := a.logSource.GetLogs(a.ctx, pipelineRun.Namespace, podName, containerName, int64(tailLines))
+	if err != nil {
+		a.logger.Error(err, "Unable to get the logs of the failed step", "Pod", podName, "Container", containerName)
+		return
+	}
+
+	a.release.Status.FailureLog = tekton.TruncateLog(logs, maxFailureLogSize)
+}
+
+// registerReleasePipelineRunTampering compares the pipeline referenced by the given release PipelineRun with the one it
+// was created with. If they differ, the release PipelineRun was modified and the Release status no longer matches what
+// is being executed, so the tampering is reported in the Release status.
+func (a *Adapter) registerReleasePipelineRunTampering(pipelineRun *v1beta1.PipelineRun) {
+	if a.release.Status.Pipeline == "" || a.release.IsPipelineRunTampered() {
+		return
+	}
+
+	if pipeline := tekton.GetPipelineReference(pipelineRun); pipeline != a.release.Status.Pipeline {
+		a.logger.Info("The release PipelineRun references a different pipeline",
+			"Expected", a.release.Status.Pipeline, "Actual", pipeline)
+		a.release.MarkPipelineRunTampered(fmt.Sprintf("the release PipelineRun references the pipeline %s instead of %s",
+			pipeline, a.release.Status.Pipeline))
+	}
 }
 
 // registerReleasePipelineRunStatus updates the status of the Release being processed by monitoring the status of the
-// associated release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
-// started/succeeded, no action will be taken.
+// associated release PipelineRun and setting the appropriate state in the Release. The start time of the Release is
+// taken from the PipelineRun as soon as it's reported. If the PipelineRun hasn't finished, only its spec.status will be
+// reflected in the Release.
 func (a *Adapter) registerReleasePipelineRunStatus(pipelineRun *v1beta1.PipelineRun) error {
-	if pipelineRun != nil && pipelineRun.IsDone() {
-		patch := client.MergeFrom(a.release.DeepCopy())
+	if pipelineRun == nil {
+		return nil
+	}
+
+	// The PipelineRun might start some time after being created, so use its start time once it's known
+	if pipelineRun.Status.StartTime != nil {
+		a.release.Status.StartTime = pipelineRun.Status.StartTime.DeepCopy()
+	}
+
+	a.registerReleasePipelineRunReason(pipelineRun)
+
+	if !pipelineRun.IsDone() {
+		a.registerReleasePipelineRunSpecStatus(pipelineRun)
+		return nil
+	}
+
+	// The results are registered before marking the Release as finished, so it's not reported as succeeded until
+	// they are stored and registering them is retried if it fails
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	resultsOutputWritten := true
+	if condition.IsTrue() {
+		err := a.registerReleasePipelineRunResultLabels(pipelineRun)
+		if err != nil {
+			return err
+		}
 
-		a.release.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+		resultsOutputWritten, err = a.registerReleasePipelineRunResultsOutput(pipelineRun)
+		if err != nil {
+			return err
+		}
 
-		condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-		if condition.IsTrue() {
-			a.release.MarkSucceeded()
-		} else {
-			a.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, condition.Message)
+		resultsOutput := a.release.Spec.ResultsOutput
+		if resultsOutputWritten && (resultsOutput == nil || resultsOutput.Kind != v1alpha1.ResultsOutputKindSecret) {
+			a.release.Status.Results = getReleaseResults(pipelineRun)
 		}
+	}
 
-		return a.client.Status().Patch(a.ctx, a.release, patch)
+	a.release.Status.CompletionTime = &metav1.Time{Time: a.clock.Now()}
+
+	if !resultsOutputWritten {
+		resultsOutput := a.release.Spec.ResultsOutput
+		a.release.MarkFailed(v1alpha1.ReleaseReasonResultsOutputConflict,
+			fmt.Sprintf("the %s %s already exists and isn't owned by the Release, so the results weren't written to it",
+				resultsOutput.Kind, resultsOutput.Name))
+	} else if condition.IsTrue() {
+		a.release.MarkSucceededWithMessage(condition.Message)
+	} else if isPipelineRunCancelled(pipelineRun) {
+		a.release.MarkFailed(v1alpha1.ReleaseReasonPipelineCancelled, condition.Message)
+	} else {
+		a.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, condition.Message)
+		a.registerReleasePipelineRunFailureLog(pipelineRun)
 	}
 
+	a.recordReleaseCompletionEvent(pipelineRun)
+
 	return nil
 }
 
@@ -407,22 +1673,179 @@ func (a *Adapter) registerReleaseStatusData(releasePipelineRun *v1beta1.Pipeline
 		return nil
 	}
 
-	patch := client.MergeFrom(a.release.DeepCopy())
-
 	a.release.Status.ReleasePipelineRun = fmt.Sprintf("%s%c%s",
 		releasePipelineRun.Namespace, types.Separator, releasePipelineRun.Name)
+	a.release.Status.PipelineRunRef = &corev1.ObjectReference{
+		APIVersion: v1beta1.SchemeGroupVersion.String(),
+		Kind:       "PipelineRun",
+		Name:       releasePipelineRun.Name,
+		Namespace:  releasePipelineRun.Namespace,
+		UID:        releasePipelineRun.UID,
+	}
+	a.release.Status.Pipeline = tekton.GetPipelineReference(releasePipelineRun)
 	a.release.Status.ReleaseStrategy = fmt.Sprintf("%s%c%s",
 		releaseStrategy.Namespace, types.Separator, releaseStrategy.Name)
+	a.release.Status.Application = releasePipelineRun.Labels[tekton.ApplicationNameLabel]
 	a.release.Status.Target = releasePipelineRun.Namespace
+	a.release.Status.Params = releaseStrategy.Spec.Params
+	if a.release.Status.SourceAnnotations == nil {
+		a.release.Status.SourceAnnotations = getSourceAnnotations(a.release)
+	}
+
+	previousRelease, err := a.loader.GetPreviousSuccessfulRelease(a.ctx, a.client, a.release)
+	if err != nil {
+		return err
+	}
+	if previousRelease != nil && previousRelease.Status.ReleaseStrategy == a.release.Status.ReleaseStrategy {
+		a.release.Status.ParamsDiff = getParamsDiff(previousRelease.Status.Params, a.release.Status.Params)
+	}
 
 	a.release.MarkRunning()
 
-	return a.client.Status().Patch(a.ctx, a.release, patch)
+	return nil
+}
+
+// registerWorkspaceStorageStatus sets the WorkspaceStorageBinding condition of the Release being processed from the
+// phase of the PersistentVolumeClaims backing the workspaces of the given release PipelineRun, so users waiting for the
+// storage to be provisioned understand why the PipelineRun doesn't progress. It returns true if any of them is still
+// pending. PersistentVolumeClaims that don't exist are skipped, as Tekton reports them in the PipelineRun status.
+func (a *Adapter) registerWorkspaceStorageStatus(pipelineRun *v1beta1.PipelineRun) (bool, error) {
+	if a.release.IsWorkspaceStorageBound() {
+		return false, nil
+	}
+
+	var found bool
+	for _, workspace := range pipelineRun.Spec.Workspaces {
+		if workspace.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		claimName := workspace.PersistentVolumeClaim.ClaimName
+		persistentVolumeClaim, err := a.loader.GetPersistentVolumeClaim(a.ctx, a.client, claimName, pipelineRun.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		found = true
+
+		if persistentVolumeClaim.Status.Phase == corev1.ClaimPending {
+			a.release.MarkWorkspaceStoragePending(fmt.Sprintf("PersistentVolumeClaim %s%c%s backing workspace %s is pending",
+				pipelineRun.Namespace, types.Separator, claimName, workspace.Name))
+			return true, nil
+		}
+	}
+
+	if found {
+		a.release.MarkWorkspaceStorageBound()
+	}
+
+	return false, nil
+}
+
+// registerReleaseSummaryAnnotation writes the summary of the outcome of the Release being processed in its summary
+// annotation once it's done. The annotation is only written when its content changes, so GitOps tools see a single
+// stable signal instead of every status change. If the Release no longer exists, nothing will be written.
+func (a *Adapter) registerReleaseSummaryAnnotation() error {
+	summary, err := getReleaseSummary(a.release)
+	if err != nil || summary == "" || a.release.GetAnnotations()[v1alpha1.SummaryAnnotation] == summary {
+		return err
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	annotations := a.release.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1alpha1.SummaryAnnotation] = summary
+	a.release.SetAnnotations(annotations)
+
+	return client.IgnoreNotFound(a.patchRelease(patch))
+}
+
+// recordEvent records an event of the given type and reason for the Release being processed. If no recorder is set,
+// nothing will be recorded.
+func (a *Adapter) recordEvent(eventType, reason, messageFmt string, args ...interface{}) {
+	if a.recorder == nil {
+		return
+	}
+
+	a.recorder.Eventf(a.release, eventType, reason, messageFmt, args...)
+}
+
+// recordDeprecatedReleaseStrategyEvent records a warning event in the Release being processed stating that the given
+// ReleaseStrategy is deprecated, pointing to its replacement if the ReleaseStrategy declares one.
+func (a *Adapter) recordDeprecatedReleaseStrategyEvent(releaseStrategy *v1alpha1.ReleaseStrategy) {
+	message := fmt.Sprintf("ReleaseStrategy %s%c%s is deprecated",
+		releaseStrategy.Namespace, types.Separator, releaseStrategy.Name)
+	if replacement := releaseStrategy.GetAnnotations()[v1alpha1.ReplacedByAnnotation]; replacement != "" {
+		message += fmt.Sprintf(", use %s instead", replacement)
+	}
+
+	a.logger.Info("Using a deprecated ReleaseStrategy", "ReleaseStrategy.Name", releaseStrategy.Name,
+		"ReleaseStrategy.Namespace", releaseStrategy.Namespace)
+	a.recordEvent(corev1.EventTypeWarning, "UsingDeprecatedStrategy", "%s", message)
+}
+
+// recordReleaseCompletionEvent records an event for the Release being processed once it finishes. Besides the human
+// readable message, the event carries the fields returned by getCompletionEventAnnotations as annotations, so
+// automation consuming the events can act on them without parsing the message.
+func (a *Adapter) recordReleaseCompletionEvent(pipelineRun *v1beta1.PipelineRun) {
+	if a.recorder == nil || !a.release.IsDone() {
+		return
+	}
+
+	eventType, message := corev1.EventTypeNormal, "Release succeeded"
+	if !a.release.HasSucceeded() {
+		eventType, message = corev1.EventTypeWarning, "Release failed"
+	}
+
+	reason := "ReleaseCompleted"
+	if condition := meta.FindStatusCondition(a.release.Status.Conditions, string(apis.ConditionSucceeded)); condition != nil {
+		reason = condition.Reason
+		if condition.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, condition.Message)
+		}
+	}
+
+	a.recorder.AnnotatedEventf(a.release, getCompletionEventAnnotations(a.release, pipelineRun),
+		eventType, reason, "%s", message)
+}
+
+// getReleaseWebhooks returns the webhooks declared in the ReleaseStrategy of the Release being processed. If the
+// ReleaseStrategy can't be found, the error is logged and nil is returned, so the Release is still notified to its
+// notification channels.
+func (a *Adapter) getReleaseWebhooks() *v1alpha1.Webhooks {
+	releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
+	if err != nil {
+		a.logger.Error(err, "Unable to get the ReleasePlanAdmission to send the Release notification")
+		return nil
+	}
+
+	releaseStrategy, err := a.getReleaseStrategy(releasePlanAdmission)
+	if err != nil {
+		a.logger.Error(err, "Unable to get the ReleaseStrategy to send the Release notification")
+		return nil
+	}
+
+	return releaseStrategy.Spec.Webhooks
+}
+
+// setReleaseAsControllerOwner sets the Release being processed as the controller owner of the given PipelineRun, so
+// it's garbage collected along with the Release. Owner references can't point to objects in other namespaces, so
+// PipelineRuns running outside the Release namespace are left untouched and deleted by the Release finalizer instead.
+func (a *Adapter) setReleaseAsControllerOwner(pipelineRun *v1beta1.PipelineRun) error {
+	if pipelineRun.Namespace != a.release.Namespace {
+		return nil
+	}
+
+	return ctrl.SetControllerReference(a.release, pipelineRun, a.client.Scheme())
 }
 
 // syncResources sync all the resources needed to trigger the deployment of the Release being processed.
 func (a *Adapter) syncResources() error {
-	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	releasePlanAdmission, err := a.getActiveReleasePlanAdmission()
 	if err != nil {
 		return err
 	}
@@ -20,13 +20,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/loader"
+	"github.com/redhat-appstudio/release-service/notifier"
+	"github.com/redhat-appstudio/release-service/oci"
 	"github.com/redhat-appstudio/release-service/tekton"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
@@ -34,12 +43,134 @@ import (
 
 	"github.com/operator-framework/operator-lib/handler"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 )
 
+// throttlingClient is a client whose creations are rejected as if the API server was rate-limiting requests
+type throttlingClient struct {
+	client.Client
+	retryAfterSeconds int
+}
+
+func (c *throttlingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return errors.NewTooManyRequests("the server is rate-limiting requests", c.retryAfterSeconds)
+}
+
+// statusWriteCountingClient is a client counting the status writes it performs
+type statusWriteCountingClient struct {
+	client.Client
+	statusWrites int
+}
+
+func (c *statusWriteCountingClient) Status() client.StatusWriter {
+	return &statusWriteCountingWriter{c.Client.Status(), &c.statusWrites}
+}
+
+// statusWriteCountingWriter is a status writer increasing the given counter on each write
+type statusWriteCountingWriter struct {
+	client.StatusWriter
+	statusWrites *int
+}
+
+func (w *statusWriteCountingWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	*w.statusWrites++
+	return w.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func (w *statusWriteCountingWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	*w.statusWrites++
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+// conflictingClient is a client failing the given number of status writes with a Conflict error before letting them
+// through
+type conflictingClient struct {
+	client.Client
+	conflicts    int
+	statusWrites int
+}
+
+func (c *conflictingClient) Status() client.StatusWriter {
+	return &conflictingStatusWriter{c.Client.Status(), c}
+}
+
+// conflictingStatusWriter is a status writer failing the writes with a Conflict error while its client has conflicts left
+type conflictingStatusWriter struct {
+	client.StatusWriter
+	client *conflictingClient
+}
+
+func (w *conflictingStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	w.client.statusWrites++
+	if w.client.conflicts > 0 {
+		w.client.conflicts--
+		return errors.NewConflict(schema.GroupResource{Resource: "releases"}, obj.GetName(), fmt.Errorf("conflict"))
+	}
+
+	return w.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// fakeLogSource is a log source returning the given logs and error, recording the container it was asked for
+type fakeLogSource struct {
+	containerName string
+	err           error
+	logs          string
+	podName       string
+}
+
+func (s *fakeLogSource) GetLogs(_ context.Context, _, podName, containerName string, _ int64) (string, error) {
+	s.podName = podName
+	s.containerName = containerName
+	return s.logs, s.err
+}
+
+// fakeEventRecorder is an event recorder keeping the annotations of the events it records, which are dropped by the
+// FakeRecorder provided by client-go
+type fakeEventRecorder struct {
+	annotations []map[string]string
+	events      []string
+}
+
+func (r *fakeEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (r *fakeEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (r *fakeEventRecorder) AnnotatedEventf(_ runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.annotations = append(r.annotations, annotations)
+	r.events = append(r.events, fmt.Sprintf(eventtype+" "+reason+" "+messageFmt, args...))
+}
+
+// fakeArtifactResolver is an OCI resolver serving a single artifact from memory
+type fakeArtifactResolver struct {
+	content []byte
+	err     error
+}
+
+func (r *fakeArtifactResolver) Digest(_ context.Context, _ string) (string, error) {
+	return "sha256:0000000000000000000000000000000000000000000000000000000000000000", r.err
+}
+
+func (r *fakeArtifactResolver) Fetch(_ context.Context, _, _ string) ([]byte, error) {
+	return r.content, nil
+}
+
 var _ = Describe("Release Adapter", Ordered, func() {
 	var (
 		createReleaseAndAdapter func() *Adapter
@@ -71,6 +202,100 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When FlushStatus is called", func() {
+		var (
+			adapter        *Adapter
+			countingClient *statusWriteCountingClient
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			countingClient = &statusWriteCountingClient{Client: k8sClient}
+			adapter.client = countingClient
+		})
+
+		It("should not write the status if it didn't change", func() {
+			Expect(adapter.FlushStatus()).To(Succeed())
+			Expect(countingClient.statusWrites).To(Equal(0))
+		})
+
+		It("should write the status changes of several operations in a single write", func() {
+			os.Setenv("ALLOW_TARGET_OVERRIDE", "true")
+			defer os.Unsetenv("ALLOW_TARGET_OVERRIDE")
+
+			adapter.release.Labels = map[string]string{v1alpha1.OverrideTargetLabel: "true"}
+			adapter.release.Spec.OverrideTarget = "default"
+			adapter.release.Spec.DependsOn = []string{"non-existing-release"}
+
+			_, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(err).NotTo(HaveOccurred())
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(countingClient.statusWrites).To(Equal(0))
+
+			Expect(adapter.FlushStatus()).To(Succeed())
+			Expect(countingClient.statusWrites).To(Equal(1))
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Status.TargetOverridden).To(BeTrue())
+			Expect(release.Status.Conditions).To(HaveLen(1))
+			Expect(release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForDependency)))
+
+			Expect(adapter.FlushStatus()).To(Succeed())
+			Expect(countingClient.statusWrites).To(Equal(1))
+		})
+
+		It("should keep the pending status changes when the Release metadata is patched", func() {
+			adapter.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForConcurrencySlot, "")
+
+			result, err := adapter.EnsureFinalizerIsAdded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+
+			Expect(adapter.FlushStatus()).To(Succeed())
+			Expect(countingClient.statusWrites).To(Equal(1))
+		})
+
+		It("should apply the status changes again if the Release was modified by someone else", func() {
+			conflicting := &conflictingClient{Client: k8sClient, conflicts: 1}
+			adapter.client = conflicting
+
+			patch := client.MergeFrom(adapter.release.DeepCopy())
+			modifiedRelease := adapter.release.DeepCopy()
+			modifiedRelease.Annotations = map[string]string{"modified": "true"}
+			Expect(k8sClient.Patch(ctx, modifiedRelease, patch)).To(Succeed())
+
+			adapter.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForConcurrencySlot, "")
+			Expect(adapter.FlushStatus()).To(Succeed())
+			Expect(conflicting.statusWrites).To(Equal(2))
+			Expect(adapter.release.Annotations).To(HaveKeyWithValue("modified", "true"))
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Status.Conditions).To(HaveLen(1))
+			Expect(release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForConcurrencySlot)))
+		})
+
+		It("should succeed if the Release no longer exists", func() {
+			Expect(k8sClient.Delete(ctx, adapter.release)).To(Succeed())
+			adapter.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForConcurrencySlot, "")
+			Expect(adapter.FlushStatus()).To(Succeed())
+		})
+	})
+
 	Context("When EnsureFinalizersAreCalled is called", func() {
 		var adapter *Adapter
 
@@ -130,248 +355,233 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(err).To(HaveOccurred())
 			Expect(errors.IsNotFound(err)).To(BeTrue())
 		})
-	})
 
-	Context("When EnsureFinalizerIsAdded is called", func() {
-		var adapter *Adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
-		})
+		It("should delete the release PipelineRun running in another namespace before removing the finalizer", func() {
+			managedNamespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "finalizer-managed",
+				},
+			}
+			Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx, managedNamespace))).To(Succeed())
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cross-namespace-pipeline-run",
+					Namespace: managedNamespace.Name,
+					Labels: map[string]string{
+						tekton.PipelinesTypeLabel:    tekton.PipelineTypeRelease,
+						tekton.ReleaseNameLabel:      adapter.release.Name,
+						tekton.ReleaseNamespaceLabel: adapter.release.Namespace,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
 
-		It("should add a finalizer to the release", func() {
 			result, err := adapter.EnsureFinalizerIsAdded()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeFalse())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Finalizers).To(ContainElement(finalizerName))
-		})
 
-		It("shouldn't fail if the release already has the finalizer added", func() {
-			result, err := adapter.EnsureFinalizerIsAdded()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(adapter.client.Delete(adapter.ctx, adapter.release)).To(Succeed())
+			adapter.release, err = adapter.loader.GetRelease(adapter.ctx, adapter.client, adapter.release.Name, adapter.release.Namespace)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Finalizers).To(ContainElement(finalizerName))
+			Expect(adapter.release.DeletionTimestamp).NotTo(BeNil())
 
-			result, err = adapter.EnsureFinalizerIsAdded()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			result, err = adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-		})
-	})
-
-	Context("When EnsureReleasePlanAdmissionEnabled is called", func() {
-		var adapter *Adapter
+			Expect(adapter.release.Finalizers).NotTo(ContainElement(finalizerName))
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: pipelineRun.Name, Namespace: pipelineRun.Namespace}, pipelineRun)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+		It("should hold the finalizer while the failed pipelineRun is retained", func() {
+			os.Setenv("RELEASE_PIPELINE_FAILED_RETENTION", "3600")
+			defer os.Unsetenv("RELEASE_PIPELINE_FAILED_RETENTION")
 
-		It("should succeed if the ReleasePlanAdmission is enabled", func() {
-			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-		})
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			adapter.clock = fakeClock
 
-		It("should stop reconcile if the ReleasePlanAdmission is found to be disabled", func() {
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("auto-release label set to false"),
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "failed-pipeline-run",
+					Namespace: "default",
 				},
-			})
-			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetDisabledError)))
-		})
-
-		It("should stop reconcile if multiple ReleasePlanAdmissions exist", func() {
+			}
+			pipelineRun.Status.MarkFailed("Failed", "")
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: fakeClock.Now().Add(-10 * time.Minute)}
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("multiple ReleasePlanAdmissions found"),
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
 				},
 			})
-			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			adapter.release.DeletionTimestamp = &metav1.Time{Time: fakeClock.Now()}
+			controllerutil.AddFinalizer(adapter.release, finalizerName)
+
+			result, err := adapter.EnsureFinalizersAreCalled()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(50 * time.Minute))
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+			Expect(adapter.release.Finalizers).To(ContainElement(finalizerName))
 		})
 	})
 
-	Context("When EnsureReleasePipelineRunExists is called", func() {
+	Context("When getDefaultReleasePipelineRunTimeout is called", func() {
 		var adapter *Adapter
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT")
 		})
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			os.Setenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT", "3600")
 		})
 
-		It("should continue if the pipelineRun exists and the release has started", func() {
+		It("returns the default timeout if the release Pipeline has tasks without a timeout", func() {
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource: &v1beta1.PipelineRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "pipeline-run",
-							Namespace: "default",
+					ContextKey: loader.ReleasePipelineContextKey,
+					Resource: &v1beta1.Pipeline{
+						Spec: v1beta1.PipelineSpec{
+							Tasks: []v1beta1.PipelineTask{{Name: "push"}},
 						},
 					},
 				},
 			})
 
-			adapter.release.MarkRunning()
-
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			timeout, err := adapter.getDefaultReleasePipelineRunTimeout(releaseStrategy)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(timeout).To(Equal(time.Hour))
 		})
 
-		It("should track the status data if the pipelineRun already exists", func() {
+		It("returns the default timeout if the release Pipeline can't be found", func() {
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource: &v1beta1.PipelineRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "pipeline-run",
-							Namespace: "default",
-						},
-					},
-				},
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
+					ContextKey: loader.ReleasePipelineContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
 				},
 			})
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			timeout, err := adapter.getDefaultReleasePipelineRunTimeout(releaseStrategy)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(timeout).To(Equal(time.Hour))
 		})
 
-		It("should create a pipelineRun and track the status data if all the required resources are present", func() {
+		It("returns zero if every task of the release Pipeline sets a timeout", func() {
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
-				},
-				{
-					ContextKey: loader.EnterpriseContractPolicyContextKey,
-					Resource:   enterpriseContractPolicy,
-				},
-				{
-					ContextKey: loader.SnapshotContextKey,
-					Resource:   snapshot,
+					ContextKey: loader.ReleasePipelineContextKey,
+					Resource: &v1beta1.Pipeline{
+						Spec: v1beta1.PipelineSpec{
+							Tasks: []v1beta1.PipelineTask{{Name: "push", Timeout: &metav1.Duration{Duration: time.Minute}}},
+						},
+					},
 				},
 			})
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			timeout, err := adapter.getDefaultReleasePipelineRunTimeout(releaseStrategy)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(timeout).To(BeZero())
+		})
 
-			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
-			Expect(pipelineRun).NotTo(BeNil())
+		It("returns zero if the ReleaseStrategy sets its own timeout", func() {
+			timeoutReleaseStrategy := releaseStrategy.DeepCopy()
+			timeoutReleaseStrategy.Spec.Timeout = &metav1.Duration{Duration: 2 * time.Hour}
+
+			timeout, err := adapter.getDefaultReleasePipelineRunTimeout(timeoutReleaseStrategy)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+			Expect(timeout).To(BeZero())
 		})
 
-		It("should fail if the ReleasePlanAdmission is not found", func() {
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("not found"),
-				},
-			})
+		It("returns zero if the default timeout is disabled", func() {
+			os.Setenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT", "0")
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			timeout, err := adapter.getDefaultReleasePipelineRunTimeout(releaseStrategy)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanValidationError)))
+			Expect(timeout).To(BeZero())
 		})
 
-		It("should fail if the ReleaseStrategy is not found", func() {
+		It("returns an error if the release Pipeline can't be loaded", func() {
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-				},
-				{
-					ContextKey: loader.ReleaseStrategyContextKey,
-					Err:        fmt.Errorf("not found"),
+					ContextKey: loader.ReleasePipelineContextKey,
+					Err:        fmt.Errorf("internal error"),
 				},
 			})
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+			_, err := adapter.getDefaultReleasePipelineRunTimeout(releaseStrategy)
+			Expect(err).To(HaveOccurred())
 		})
+	})
 
-		It("should fail if the EnterpriseContractPolicy is not found", func() {
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-				},
-				{
-					ContextKey: loader.ReleaseStrategyContextKey,
-					Resource:   releaseStrategy,
-				},
-				{
-					ContextKey: loader.EnterpriseContractPolicyContextKey,
-					Err:        fmt.Errorf("not found"),
-				},
-			})
+	Context("When getRemainingPipelineRunRetention is called", func() {
+		var (
+			adapter   *Adapter
+			fakeClock *testingclock.FakeClock
+		)
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("RELEASE_PIPELINE_FAILED_RETENTION")
 		})
 
-		It("should fail if the Snapshot is not found", func() {
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-				},
-				{
-					ContextKey: loader.ReleaseStrategyContextKey,
-					Resource:   releaseStrategy,
-				},
-				{
-					ContextKey: loader.EnterpriseContractPolicyContextKey,
-				},
-				{
-					ContextKey: loader.SnapshotContextKey,
-					Err:        fmt.Errorf("not found"),
-				},
-			})
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			fakeClock = testingclock.NewFakeClock(time.Now())
+			adapter.clock = fakeClock
+			os.Setenv("RELEASE_PIPELINE_FAILED_RETENTION", "600")
+		})
 
-			result, err := adapter.EnsureReleasePipelineRunExists()
-			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
-			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		It("returns the remaining retention of a failed pipelineRun", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("Failed", "")
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: fakeClock.Now().Add(-4 * time.Minute)}
+			Expect(adapter.getRemainingPipelineRunRetention(pipelineRun)).To(Equal(6 * time.Minute))
+
+			fakeClock.Step(10 * time.Minute)
+			Expect(adapter.getRemainingPipelineRunRetention(pipelineRun)).To(BeNumerically("<=", 0))
+		})
+
+		It("doesn't retain succeeded pipelineRuns", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("Succeeded", "")
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: fakeClock.Now()}
+			Expect(adapter.getRemainingPipelineRunRetention(pipelineRun)).To(BeZero())
+		})
+
+		It("doesn't retain pipelineRuns that are still running", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkRunning("Running", "")
+			Expect(adapter.getRemainingPipelineRunRetention(pipelineRun)).To(BeZero())
+		})
+
+		It("doesn't retain failed pipelineRuns if no retention is set", func() {
+			os.Unsetenv("RELEASE_PIPELINE_FAILED_RETENTION")
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("Failed", "")
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: fakeClock.Now()}
+			Expect(adapter.getRemainingPipelineRunRetention(pipelineRun)).To(BeZero())
 		})
 	})
 
-	Context("When EnsureReleasePipelineStatusIsTracked is called", func() {
-		var adapter *Adapter
+	Context("When EnsureExpiredReleaseIsDeleted is called", func() {
+		var (
+			adapter    *Adapter
+			fakeClock  *testingclock.FakeClock
+			successTTL int32 = 60
+			failureTTL int32 = 600
+		)
+
+		releaseDeleted := func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, &v1alpha1.Release{})
+			return errors.IsNotFound(err)
+		}
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
@@ -379,47 +589,136 @@ var _ = Describe("Release Adapter", Ordered, func() {
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
+			adapter.release.Spec.TTLSecondsAfterSuccess = &successTTL
+			adapter.release.Spec.TTLSecondsAfterFailure = &failureTTL
+			adapter.release.MarkRunning()
+
+			fakeClock = testingclock.NewFakeClock(time.Now())
+			adapter.clock = fakeClock
 		})
 
-		It("should continue if the release hasn't started or it's done", func() {
-			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+		It("should continue if the release hasn't finished", func() {
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("should track the status if the pipelineRun exists", func() {
-			adapter.release.MarkRunning()
+		It("should continue if the release is still collecting metadata", func() {
+			adapter.release.MarkSucceeded()
+			adapter.release.MarkCollectingMetadata()
+			fakeClock.Step(time.Hour)
 
-			pipelineRun := &v1beta1.PipelineRun{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
-					Namespace: "default",
-				},
-			}
-			pipelineRun.Status.MarkSucceeded("", "")
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource:   pipelineRun,
-				},
-			})
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeFalse())
+		})
 
-			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+		It("should continue if the release doesn't set a TTL for its outcome", func() {
+			adapter.release.Spec.TTLSecondsAfterSuccess = nil
+			adapter.release.MarkSucceeded()
+			fakeClock.Step(time.Hour)
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsDone()).To(BeTrue())
+			Expect(releaseDeleted()).To(BeFalse())
 		})
 
-		It("should continue if the pipelineRun doesn't exist", func() {
-			adapter.release.MarkRunning()
+		It("should requeue a succeeded release until the success TTL elapses", func() {
+			adapter.release.MarkSucceeded()
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(10 * time.Second))
 
-			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(50 * time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeFalse())
+		})
+
+		It("should delete a succeeded release once the success TTL elapses", func() {
+			adapter.release.MarkSucceeded()
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(time.Minute))
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeTrue())
+		})
+
+		It("should keep a failed release until the failure TTL elapses", func() {
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(time.Minute))
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(9 * time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeFalse())
+		})
+
+		It("should delete a failed release once the failure TTL elapses", func() {
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(10 * time.Minute))
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeTrue())
+		})
+
+		It("should delete a finished release straight away if its TTL is zero", func() {
+			zeroTTL := int32(0)
+			adapter.release.Spec.TTLSecondsAfterFailure = &zeroTTL
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(-time.Second))
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeTrue())
+		})
+
+		It("should never delete a failed release that doesn't set a failure TTL", func() {
+			adapter.release.Spec.TTLSecondsAfterFailure = nil
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			fakeClock.Step(365 * 24 * time.Hour)
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeZero())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeFalse())
+		})
+
+		It("should continue if a succeeded release is still deploying", func() {
+			adapter.release.MarkSucceeded()
+			adapter.release.MarkDeploying(metav1.ConditionUnknown, "CommitsUnsynced", "")
+			fakeClock.Step(time.Hour)
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseDeleted()).To(BeFalse())
+		})
+
+		It("should count the success TTL from the deployment completion", func() {
+			adapter.release.MarkSucceeded()
+			adapter.release.MarkDeploying(metav1.ConditionUnknown, "CommitsUnsynced", "")
+			adapter.release.MarkDeployed("CommitsSynced", "")
+			adapter.release.Status.DeploymentCompletionTime = &metav1.Time{
+				Time: adapter.release.Status.CompletionTime.Add(time.Minute),
+			}
+			fakeClock.SetTime(adapter.release.Status.CompletionTime.Add(time.Minute + 30*time.Second))
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(30 * time.Second))
+			Expect(err).NotTo(HaveOccurred())
 		})
 	})
 
-	Context("When EnsureSnapshotEnvironmentBindingExists is called", func() {
+	Context("When EnsureFinalizerIsAdded is called", func() {
 		var adapter *Adapter
 
 		AfterEach(func() {
@@ -430,145 +729,232 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			adapter = createReleaseAndAdapter()
 		})
 
-		It("skips the operation if the release has not succeeded yet", func() {
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+		It("should add a finalizer to the release", func() {
+			result, err := adapter.EnsureFinalizerIsAdded()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Finalizers).To(ContainElement(finalizerName))
 		})
 
-		It("skips the operation if the release has already being deployed", func() {
-			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
-			adapter.release.MarkDeployed("", "")
+		It("shouldn't fail if the release already has the finalizer added", func() {
+			result, err := adapter.EnsureFinalizerIsAdded()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Finalizers).To(ContainElement(finalizerName))
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			result, err = adapter.EnsureFinalizerIsAdded()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 		})
+	})
 
-		It("skips the operation if no environment is set in the ReleasePlanAdmission", func() {
-			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
+	Context("When EnsureReleaseDependenciesAreMet is called", func() {
+		var adapter *Adapter
 
-			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release has no dependencies", func() {
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should wait if a dependency has not succeeded yet", func() {
+			dependency := &v1alpha1.Release{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan-admission",
+					Name:      "dependency",
 					Namespace: "default",
 				},
-				Spec: v1alpha1.ReleasePlanAdmissionSpec{
-					Application:     "app",
-					Origin:          "default",
-					ReleaseStrategy: "strategy",
-				},
 			}
+			dependency.MarkRunning()
+			adapter.release.Spec.DependsOn = []string{dependency.Name}
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   newReleasePlanAdmission,
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
 				},
 			})
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(dependencyRequeueDelay))
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.SnapshotEnvironmentBinding).To(BeEmpty())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForDependency)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring(dependency.Name))
 		})
 
-		It("fails when the ReleasePlanAdmission is not present", func() {
-			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-
+		It("should wait if a dependency doesn't exist yet", func() {
+			adapter.release.Spec.DependsOn = []string{"non-existent"}
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Err:        fmt.Errorf("not found"),
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
 				},
 			})
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
 			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("not found"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForDependency)))
 		})
 
-		It("updates the binding if one already exists", func() {
-			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-
+		It("should continue if all the dependencies succeeded", func() {
+			dependency := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dependency",
+					Namespace: "default",
+				},
+			}
+			dependency.MarkRunning()
+			dependency.MarkSucceeded()
+			adapter.release.Spec.DependsOn = []string{dependency.Name}
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should stop reconcile if a dependency failed", func() {
+			dependency := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dependency",
+					Namespace: "default",
 				},
+			}
+			dependency.MarkRunning()
+			dependency.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			adapter.release.Spec.DependsOn = []string{dependency.Name}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
-					Resource:   snapshotEnvironmentBinding,
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonDependencyFailed)))
+		})
+
+		It("should stop reconcile if the dependencies contain a cycle", func() {
+			dependency := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dependency",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					DependsOn: []string{adapter.release.Name},
 				},
+			}
+			adapter.release.Spec.DependsOn = []string{dependency.Name}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.SnapshotEnvironmentBindingResourcesContextKey,
-					Resource: &loader.SnapshotEnvironmentBindingResources{
-						Application:           application,
-						ApplicationComponents: []applicationapiv1alpha1.Component{*component},
-						Environment:           environment,
-						Snapshot:              snapshot,
-					},
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   dependency,
 				},
 			})
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			result, err := adapter.EnsureReleaseDependenciesAreMet()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.SnapshotEnvironmentBinding).NotTo(BeEmpty())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonDependencyCycleDetected)))
+		})
+	})
 
-			binding, _ := adapter.loader.GetSnapshotEnvironmentBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
-			Expect(binding).NotTo(BeNil())
-			Expect(binding.Annotations).To(HaveLen(2))
-			Expect(binding.Annotations[handler.NamespacedNameAnnotation]).To(
-				Equal(adapter.release.Namespace + "/" + adapter.release.Name),
-			)
-			Expect(binding.Annotations[handler.TypeAnnotation]).To(Equal(adapter.release.Kind))
+	Context("When EnsureReleasePlanAdmissionEnabled is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
 		})
 
-		It("creates a binding and updates the release status", func() {
-			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should succeed if the ReleasePlanAdmission is enabled", func() {
+			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
 
+		It("should stop reconcile if the ReleasePlanAdmission is found to be disabled", func() {
 			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
 					ContextKey: loader.ReleasePlanAdmissionContextKey,
-					Resource:   releasePlanAdmission,
+					Err:        fmt.Errorf("auto-release label set to false"),
 				},
+			})
+			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetDisabledError)))
+		})
+
+		It("should stop reconcile if multiple ReleasePlanAdmissions exist", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("multiple ReleasePlanAdmissions found"),
 				},
+			})
+			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		})
+
+		It("should stop reconcile if the target namespace doesn't exist", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
 				{
-					ContextKey: loader.SnapshotEnvironmentBindingResourcesContextKey,
-					Resource: &loader.SnapshotEnvironmentBindingResources{
-						Application:           application,
-						ApplicationComponents: []applicationapiv1alpha1.Component{*component},
-						Environment:           environment,
-						Snapshot:              snapshot,
-					},
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("target namespace (foo) not found for application 'app'"),
 				},
 			})
-
-			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.Status.SnapshotEnvironmentBinding).NotTo(BeEmpty())
-
-			// Restore the context to get the actual binding
-			adapter.ctx = context.TODO()
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetNamespaceNotFound)))
+		})
 
-			binding, err := adapter.loader.GetSnapshotEnvironmentBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
-			Expect(binding).NotTo(BeNil())
+		It("should continue if no ReleasePlanAdmission is found in an existing target namespace", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found in the target (foo) for application 'app'"),
+				},
+			})
+			result, err := adapter.EnsureReleasePlanAdmissionEnabled()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(k8sClient.Delete(ctx, binding)).Should(Succeed())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
 		})
 	})
 
-	Context("When EnsureSnapshotEnvironmentBindingIsTracked is called", func() {
+	Context("When EnsureReleaseIsNotPaused is called", func() {
 		var adapter *Adapter
 
 		AfterEach(func() {
@@ -579,213 +965,3877 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			adapter = createReleaseAndAdapter()
 		})
 
-		It("skips the operation if the release has not succeeded yet", func() {
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+		It("should continue if the release doesn't have the pause annotation", func() {
+			result, err := adapter.EnsureReleaseIsNotPaused()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPaused()).To(BeFalse())
 		})
 
-		It("skips the operation if the release doesn't have an associated binding", func() {
+		It("should mark the release as paused and stop processing without requeueing if it has the pause annotation", func() {
+			adapter.release.Annotations = map[string]string{v1alpha1.PauseAnnotation: "true"}
+
+			result, err := adapter.EnsureReleaseIsNotPaused()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeZero())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPaused()).To(BeTrue())
+		})
+
+		It("should resume a paused release once the pause annotation is removed", func() {
+			adapter.release.MarkPaused()
+
+			result, err := adapter.EnsureReleaseIsNotPaused()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPaused()).To(BeFalse())
+		})
+
+		It("should not pause a release being deleted", func() {
+			adapter.release.Annotations = map[string]string{v1alpha1.PauseAnnotation: "true"}
+			adapter.release.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+			result, err := adapter.EnsureReleaseIsNotPaused()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPaused()).To(BeFalse())
+		})
+	})
+
+	Context("When EnsureReleaseIsValidated is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release has been validated already", func() {
+			adapter.release.MarkValidated()
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if the release has started", func() {
 			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			result, err := adapter.EnsureReleaseIsValidated()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+		})
+
+		It("should mark the release as invalid if the ReleasePlanAdmission is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanValidationError)))
+		})
+
+		It("should record an event if the ReleasePlanAdmission is not found", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			_, err := adapter.EnsureReleaseIsValidated()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(Equal("Warning ReleasePlanAdmissionNotFound Unable to find the ReleasePlanAdmission: not found"))
+		})
+
+		It("should requeue with an increasing delay while the ReleasePlanAdmission is missing and validate once it exists", func() {
+			fakeClock := testingclock.NewFakeClock(adapter.release.CreationTimestamp.Time.Add(time.Minute))
+			adapter.clock = fakeClock
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found in the target (default) for application 'app'"),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForReleasePlanAdmission)))
+
+			fakeClock.Step(time.Minute)
+			result, err = adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueDelay).To(Equal(2 * time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err = adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeTrue())
+		})
+
+		It("should mark the release as invalid once the ReleasePlanAdmission has been missing for too long", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			adapter.clock = testingclock.NewFakeClock(adapter.release.CreationTimestamp.Time.Add(
+				defaultReleasePlanAdmissionWaitTimeout * time.Second))
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found in the target (default) for application 'app'"),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanAdmissionNotFound)))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should mark the release as invalid if the Snapshot is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		})
+
+		It("should mark the release as invalid if the ReleasePlanAdmission references a missing ReleaseStrategy", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, releasePlanAdmission.Spec.ReleaseStrategy),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseStrategyNotFound)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring(fmt.Sprintf(
+				"ReleasePlanAdmission %s/%s references missing ReleaseStrategy", releasePlanAdmission.Namespace, releasePlanAdmission.Name)))
+		})
+
+		It("should mark the release as invalid if the ReleaseStrategy artifact can't be resolved", func() {
+			adapter.strategyResolver = oci.NewReleaseStrategyResolver(&fakeArtifactResolver{err: fmt.Errorf("unauthorized")})
+			artifactReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			artifactReleasePlanAdmission.Spec.ReleaseStrategyArtifact = "quay.io/redhat-appstudio/release-strategy:latest"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   artifactReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseStrategyArtifactError)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("unauthorized"))
+		})
+
+		It("should mark the release as invalid if its application label doesn't match the ReleasePlan", func() {
+			adapter.release.Labels = map[string]string{v1alpha1.ApplicationLabel: "other-application"}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonApplicationMismatch)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring(
+				"labeled with application other-application but its ReleasePlan release-plan is for application application"))
+		})
+
+		It("should validate the release if its application label matches the ReleasePlan", func() {
+			adapter.release.Labels = map[string]string{v1alpha1.ApplicationLabel: releasePlan.Spec.Application}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeTrue())
+		})
+
+		It("should mark the release as invalid if its ReleaseStrategy uses a bundle from a disallowed registry", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io/redhat-appstudio")
+			defer os.Unsetenv("ALLOWED_BUNDLE_REGISTRIES")
+
+			bundleReleaseStrategy := releaseStrategy.DeepCopy()
+			bundleReleaseStrategy.Spec.Bundle = "docker.io/someone/release-bundle:latest"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   bundleReleaseStrategy,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonDisallowedBundleRegistry)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("docker.io/someone/release-bundle:latest"))
+		})
+
+		It("should validate the release if its ReleaseStrategy uses a bundle from an allowed registry", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "docker.io, quay.io")
+			defer os.Unsetenv("ALLOWED_BUNDLE_REGISTRIES")
+
+			bundleReleaseStrategy := releaseStrategy.DeepCopy()
+			bundleReleaseStrategy.Spec.Bundle = "quay.io/redhat-appstudio/release-bundle:latest"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   bundleReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeTrue())
+		})
+
+		It("should mark the release as invalid if its strategy sets service accounts for tasks not in the release Pipeline", func() {
+			serviceAccountsReleaseStrategy := releaseStrategy.DeepCopy()
+			serviceAccountsReleaseStrategy.Spec.PipelineServiceAccountPerTask = map[string]string{
+				"push":   "registry-pusher",
+				"sign":   "signer",
+				"verify": "verifier",
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   serviceAccountsReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.ReleasePipelineContextKey,
+					Resource: &v1beta1.Pipeline{
+						Spec: v1beta1.PipelineSpec{
+							Tasks: []v1beta1.PipelineTask{{Name: "push"}},
+						},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("no tasks named sign, verify"))
+		})
+
+		It("should skip the task service accounts check if the release Pipeline can't be resolved", func() {
+			serviceAccountsReleaseStrategy := releaseStrategy.DeepCopy()
+			serviceAccountsReleaseStrategy.Spec.PipelineServiceAccountPerTask = map[string]string{"push": "registry-pusher"}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   serviceAccountsReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.ReleasePipelineContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeTrue())
+		})
+
+		It("should mark the release as validated before its pipelineRun is triggered", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsValidated()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValidated()).To(BeTrue())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+
+			result, err = adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(2))
+			Expect(adapter.release.Status.Conditions[0].Type).To(Equal("Validated"))
+			Expect(adapter.release.Status.Conditions[1].Type).To(Equal("Succeeded"))
+			Expect(adapter.release.Status.Conditions[1].Reason).To(Equal(string(v1alpha1.ReleaseReasonRunning)))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+	})
+
+	Context("When EnsureReleasePipelineRunExists is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the pipelineRun exists and the release has started", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+			})
+
+			adapter.release.MarkRunning()
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not trigger a new pipelineRun if the release has finished", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.Status.ReleasePipelineRun = "default/pruned-pipeline-run"
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasSucceeded()).To(BeTrue())
+
+			pipelineRuns := &v1beta1.PipelineRunList{}
+			Expect(adapter.client.List(adapter.ctx, pipelineRuns, client.MatchingLabels{
+				tekton.ReleaseNameLabel: adapter.release.Name,
+			})).To(Succeed())
+			Expect(pipelineRuns.Items).To(BeEmpty())
+		})
+
+		It("should track the status data if the pipelineRun already exists", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+		})
+
+		It("should create a pipelineRun and track the status data if all the required resources are present", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.OwnerReferences).To(HaveLen(1))
+			Expect(pipelineRun.OwnerReferences[0].Kind).To(Equal("Release"))
+			Expect(pipelineRun.OwnerReferences[0].Name).To(Equal(adapter.release.Name))
+			Expect(pipelineRun.OwnerReferences[0].UID).To(Equal(adapter.release.UID))
+			Expect(*pipelineRun.OwnerReferences[0].Controller).To(BeTrue())
+			Expect(*pipelineRun.OwnerReferences[0].BlockOwnerDeletion).To(BeTrue())
+			Expect(pipelineRun.Annotations).To(HaveKeyWithValue(tekton.ReleaseStrategyGenerationAnnotation,
+				strconv.FormatInt(releaseStrategy.Generation, 10)))
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should persist the reference to the pipelineRun as soon as it's created", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			persistedRelease := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, persistedRelease)).To(Succeed())
+			Expect(persistedRelease.Status.PipelineRunRef).NotTo(BeNil())
+			Expect(persistedRelease.Status.PipelineRunRef.Namespace).To(Equal(releasePlanAdmission.Namespace))
+			Expect(persistedRelease.Status.PipelineRunRef.Name).NotTo(BeEmpty())
+			Expect(persistedRelease.Status.ReleasePipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
+				releasePlanAdmission.Namespace, types.Separator, persistedRelease.Status.PipelineRunRef.Name)))
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      persistedRelease.Status.PipelineRunRef.Name,
+				Namespace: releasePlanAdmission.Namespace,
+			}, pipelineRun)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should create the pipelineRun in the ReleasePlanAdmission namespace if the release references a strategy", func() {
+			sharedReleaseStrategy := releaseStrategy.DeepCopy()
+			sharedReleaseStrategy.Namespace = "kube-public"
+			adapter.release.Spec.ReleaseStrategy = "kube-public/" + releaseStrategy.Name
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyByNameContextKey,
+					Resource:   sharedReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.PipelineRunRef).NotTo(BeNil())
+			Expect(adapter.release.Status.PipelineRunRef.Namespace).To(Equal(releasePlanAdmission.Namespace))
+			Expect(adapter.release.Status.Target).To(Equal(releasePlanAdmission.Namespace))
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Status.PipelineRunRef.Name,
+				Namespace: releasePlanAdmission.Namespace,
+			}, pipelineRun)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should create the pipelineRun with the default timeout if the strategy doesn't set one", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Spec.Timeouts).NotTo(BeNil())
+			Expect(pipelineRun.Spec.Timeouts.Pipeline.Duration).To(Equal(time.Hour))
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should record events when the strategy is resolved and the pipelineRun is created", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed()) }()
+
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Normal ReleaseStrategyResolved Using ReleaseStrategy %s/%s",
+				releaseStrategy.Namespace, releaseStrategy.Name)))
+			Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Normal PipelineRunCreated Created release PipelineRun %s/%s",
+				pipelineRun.Namespace, pipelineRun.Name)))
+		})
+
+		It("should only render the pipelineRun in the release status if the release is in dry-run mode", func() {
+			adapter.release.SetAnnotations(map[string]string{v1alpha1.DryRunAnnotation: "true"})
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonDryRun)))
+
+			renderedPipelineRun := &v1beta1.PipelineRun{}
+			Expect(yaml.Unmarshal([]byte(adapter.release.Status.RenderedPipelineRun), renderedPipelineRun)).To(Succeed())
+			Expect(renderedPipelineRun.Kind).To(Equal("PipelineRun"))
+			Expect(renderedPipelineRun.Spec.PipelineRef.Name).To(Equal(releaseStrategy.Spec.Pipeline))
+			Expect(renderedPipelineRun.OwnerReferences).To(HaveLen(1))
+
+			pipelineRuns := &v1beta1.PipelineRunList{}
+			Expect(k8sClient.List(ctx, pipelineRuns, client.InNamespace(renderedPipelineRun.Namespace),
+				client.MatchingLabels{tekton.ReleaseNameLabel: adapter.release.Name})).To(Succeed())
+			Expect(pipelineRuns.Items).To(BeEmpty())
+		})
+
+		It("should record a warning event pointing to the replacement if the strategy is deprecated", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			deprecatedReleaseStrategy := releaseStrategy.DeepCopy()
+			deprecatedReleaseStrategy.SetAnnotations(map[string]string{
+				v1alpha1.DeprecatedAnnotation: "true",
+				v1alpha1.ReplacedByAnnotation: "new-strategy",
+			})
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   deprecatedReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed()) }()
+
+			Expect(recorder.Events).To(HaveLen(3))
+			<-recorder.Events
+			Expect(<-recorder.Events).To(Equal(fmt.Sprintf(
+				"Warning UsingDeprecatedStrategy ReleaseStrategy %s/%s is deprecated, use new-strategy instead",
+				releaseStrategy.Namespace, releaseStrategy.Name)))
+		})
+
+		It("should not record a warning event if the strategy is not marked as deprecated", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			notDeprecatedReleaseStrategy := releaseStrategy.DeepCopy()
+			notDeprecatedReleaseStrategy.SetAnnotations(map[string]string{v1alpha1.DeprecatedAnnotation: "false"})
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   notDeprecatedReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed()) }()
+
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(HavePrefix("Normal ReleaseStrategyResolved"))
+			Expect(<-recorder.Events).To(HavePrefix("Normal PipelineRunCreated"))
+		})
+
+		It("should record a warning event without a replacement if the deprecated strategy doesn't declare one", func() {
+			recorder := record.NewFakeRecorder(10)
+			adapter.recorder = recorder
+			deprecatedReleaseStrategy := releaseStrategy.DeepCopy()
+			deprecatedReleaseStrategy.SetAnnotations(map[string]string{v1alpha1.DeprecatedAnnotation: "true"})
+
+			adapter.recordDeprecatedReleaseStrategyEvent(deprecatedReleaseStrategy)
+			Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Warning UsingDeprecatedStrategy ReleaseStrategy %s/%s is deprecated",
+				releaseStrategy.Namespace, releaseStrategy.Name)))
+		})
+
+		It("should converge on the existing pipelineRun instead of creating a duplicate if it's not observable yet", func() {
+			existingPipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			// Drop the labels so the loader doesn't find it, as it happens while the cache hasn't observed it
+			existingPipelineRun.Labels = nil
+			Expect(adapter.client.Create(adapter.ctx, existingPipelineRun)).To(Succeed())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, existingPipelineRun)).To(Succeed()) }()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(adapter.release.Status.ReleasePipelineRun).To(Equal(
+				fmt.Sprintf("%s/%s", existingPipelineRun.Namespace, existingPipelineRun.Name)))
+
+			// The missing labels are applied to the existing PipelineRun
+			pipelineRuns := &v1beta1.PipelineRunList{}
+			Expect(adapter.client.List(adapter.ctx, pipelineRuns, client.MatchingLabels{
+				tekton.ReleaseNameLabel: adapter.release.Name,
+			})).To(Succeed())
+			Expect(pipelineRuns.Items).To(HaveLen(1))
+			Expect(pipelineRuns.Items[0].UID).To(Equal(existingPipelineRun.UID))
+		})
+
+		It("should converge on the same pipelineRun across repeated reconciles", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			var uids []types.UID
+			for i := 0; i < 2; i++ {
+				// Reset the status as if the Release was reconciled again before the cache observed the PipelineRun
+				// and the Release status update
+				adapter.release.Status = v1alpha1.ReleaseStatus{}
+
+				result, err := adapter.EnsureReleasePipelineRunExists()
+				Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(adapter.release.Status.PipelineRunRef).NotTo(BeNil())
+				uids = append(uids, adapter.release.Status.PipelineRunRef.UID)
+			}
+			Expect(uids[1]).To(Equal(uids[0]))
+
+			pipelineRuns := &v1beta1.PipelineRunList{}
+			Expect(adapter.client.List(adapter.ctx, pipelineRuns, client.MatchingLabels{
+				tekton.ReleaseNameLabel: adapter.release.Name,
+			})).To(Succeed())
+			Expect(pipelineRuns.Items).To(HaveLen(1))
+			Expect(pipelineRuns.Items[0].ManagedFields).To(ContainElement(
+				HaveField("Manager", Equal(pipelineRunFieldManager))))
+
+			Expect(adapter.client.Delete(adapter.ctx, &pipelineRuns.Items[0])).To(Succeed())
+		})
+
+		It("should take the ownership of the fields it sets when they conflict with another field manager", func() {
+			conflictingPipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			conflictingPipelineRun.SetGroupVersionKind(v1beta1.SchemeGroupVersion.WithKind("PipelineRun"))
+			conflictingPipelineRun.Labels[tekton.ReleaseNameLabel] = "other-release"
+			Expect(adapter.client.Patch(adapter.ctx, conflictingPipelineRun, client.Apply,
+				client.FieldOwner("other-manager"))).To(Succeed())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, conflictingPipelineRun)).To(Succeed()) }()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			Expect(adapter.client.Get(adapter.ctx, types.NamespacedName{
+				Name:      conflictingPipelineRun.Name,
+				Namespace: conflictingPipelineRun.Namespace,
+			}, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Labels[tekton.ReleaseNameLabel]).To(Equal(adapter.release.Name))
+		})
+
+		It("should fall back to a generated name if the pipelineRun name is taken by another pipelineRun", func() {
+			collidingPipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			collidingPipelineRun.Labels = nil
+			collidingPipelineRun.Annotations = map[string]string{
+				handler.NamespacedNameAnnotation: "other-namespace/other-release",
+			}
+			Expect(adapter.client.Create(adapter.ctx, collidingPipelineRun)).To(Succeed())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, collidingPipelineRun)).To(Succeed()) }()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.PipelineRunRef).NotTo(BeNil())
+			Expect(adapter.release.Status.PipelineRunRef.Name).To(HavePrefix(releasePipelineRunPrefix + "-"))
+			Expect(adapter.release.Status.PipelineRunRef.UID).NotTo(Equal(collidingPipelineRun.UID))
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			Expect(adapter.client.Get(adapter.ctx, types.NamespacedName{
+				Name:      adapter.release.Status.PipelineRunRef.Name,
+				Namespace: adapter.release.Status.PipelineRunRef.Namespace,
+			}, pipelineRun)).To(Succeed())
+			defer func() { Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed()) }()
+
+			// The colliding PipelineRun is left untouched
+			Expect(adapter.client.Get(adapter.ctx, client.ObjectKeyFromObject(collidingPipelineRun),
+				collidingPipelineRun)).To(Succeed())
+			Expect(collidingPipelineRun.Labels).NotTo(HaveKey(tekton.ReleaseNameLabel))
+		})
+
+		It("should create the pipelineRun again if it no longer exists and the release is running", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.Status.ReleasePipelineRun = "default/deleted-pipeline-run"
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ReleasePipelineRun).To(Equal(pipelineRun.Namespace + "/" + pipelineRun.Name))
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should mark the release as failed if its pipelineRun no longer exists and failing is enabled", func() {
+			os.Setenv("RELEASE_PIPELINE_FAIL_ON_MISSING", "true")
+			defer os.Unsetenv("RELEASE_PIPELINE_FAIL_ON_MISSING")
+
+			adapter.release.MarkRunning()
+			adapter.release.Status.ReleasePipelineRun = "default/deleted-pipeline-run"
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeTrue())
+			Expect(adapter.release.HasSucceeded()).To(BeFalse())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the ReleasePlanAdmission is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanValidationError)))
+		})
+
+		It("should fail with the ReleasePlanNotFound reason if the ReleasePlan doesn't exist", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{Resource: "releaseplans"}, "release-plan"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanNotFound)))
+		})
+
+		It("should fail with the ReleasePlanAdmissionNotFound reason if no ReleasePlanAdmission matches", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found in the target (default) for application 'app'"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleasePlanAdmissionNotFound)))
+		})
+
+		It("should fail with the ReleaseStrategyNotFound reason if the ReleaseStrategy doesn't exist", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{Resource: "releasestrategies"}, "strategy"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseStrategyNotFound)))
+		})
+
+		It("should fail with the PipelineCreationFailed reason if the pipelineRun is rejected", func() {
+			invalidSnapshot := snapshot.DeepCopy()
+			invalidSnapshot.Spec.Application = "not a valid label value!"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   invalidSnapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonPipelineCreationFailed)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("the release PipelineRun was rejected"))
+		})
+
+		It("should fail if the ReleaseStrategy is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		})
+
+		It("should fail if the EnterpriseContractPolicy is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   releaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		})
+
+		It("should fail if the Snapshot is not found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   releaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonValidationError)))
+		})
+
+		It("should create a pipelineRun if the params size is under the maximum allowed", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE", "1048576")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should adopt a running pipelineRun with the same inputs if deduplication is enabled", func() {
+			os.Setenv("DEDUPLICATE_PIPELINE_RUNS", "true")
+			defer os.Unsetenv("DEDUPLICATE_PIPELINE_RUNS")
+
+			duplicatedPipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			duplicatedPipelineRun.Name = "duplicated-pipeline-run"
+			duplicatedPipelineRun.Labels[tekton.InputsHashLabel] = tekton.GetInputsHash(duplicatedPipelineRun)
+			duplicatedPipelineRun.Labels[tekton.ReleaseNameLabel] = "other-release"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+				{
+					ContextKey: loader.RunningReleasePipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{*duplicatedPipelineRun},
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(adapter.release.Status.ReleasePipelineRun).To(Equal(duplicatedPipelineRun.Namespace + "/duplicated-pipeline-run"))
+
+			pipelineRuns := &v1beta1.PipelineRunList{}
+			Expect(adapter.client.List(adapter.ctx, pipelineRuns, client.MatchingLabels{
+				tekton.ReleaseNameLabel:      adapter.release.Name,
+				tekton.ReleaseNamespaceLabel: adapter.release.Namespace,
+			})).To(Succeed())
+			Expect(pipelineRuns.Items).To(BeEmpty())
+		})
+
+		It("should label the pipelineRun with its inputs hash if deduplication is enabled", func() {
+			os.Setenv("DEDUPLICATE_PIPELINE_RUNS", "true")
+			defer os.Unsetenv("DEDUPLICATE_PIPELINE_RUNS")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+				{
+					ContextKey: loader.RunningReleasePipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{},
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(tekton.InputsHashLabel,
+				tekton.GetInputsHash(adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot))))
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should fail if the params size is over the maximum allowed", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE", "10")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonParamsTooLarge)))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should wait for a concurrency slot if the maximum of running pipelineRuns has been reached", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_CONCURRENT", "1")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_CONCURRENT")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.RunningReleasePipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{{}},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(concurrencySlotRequeueDelay))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForConcurrencySlot)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("1 release PipelineRuns in flight"))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should wait if the maximum of managed pipelineRuns in the target namespace has been reached", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE", "2")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.RunningManagedPipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{{}, {}},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(concurrencySlotRequeueDelay))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetNamespaceSaturated)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("maximum allowed is 2"))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create a pipelineRun if the managed pipelineRuns in the target namespace are below the maximum", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE", "2")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE")
+
+			adapter.release.MarkWaiting(v1alpha1.ReleaseReasonTargetNamespaceSaturated, "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.RunningManagedPipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{{}},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonRunning)))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(tekton.ManagedByLabel, tekton.ManagedByLabelValue))
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should create a pipelineRun and clear the waiting condition once a concurrency slot is free", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_CONCURRENT", "1")
+			defer os.Unsetenv("RELEASE_PIPELINE_MAX_CONCURRENT")
+
+			adapter.release.MarkWaiting(v1alpha1.ReleaseReasonWaitingForConcurrencySlot, "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.RunningReleasePipelineRunsContextKey,
+					Resource:   []v1beta1.PipelineRun{},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonRunning)))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should back off if the API server is throttling the pipelineRun creation", func() {
+			adapter.client = &throttlingClient{Client: k8sClient}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(defaultPipelineRunCreationBackoff * time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+		})
+
+		It("should honor the Retry-After hint if the API server is throttling the pipelineRun creation", func() {
+			adapter.client = &throttlingClient{Client: k8sClient, retryAfterSeconds: 60}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(60 * time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+		})
+
+		It("should wait if the target namespace has no quota headroom left", func() {
+			os.Setenv("RELEASE_PIPELINE_QUOTA_CHECK", "true")
+			defer os.Unsetenv("RELEASE_PIPELINE_QUOTA_CHECK")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.ResourceQuotasContextKey,
+					Resource: []corev1.ResourceQuota{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+							Status: corev1.ResourceQuotaStatus{
+								Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+								Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+							},
+						},
+					},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(insufficientQuotaRequeueDelay))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonInsufficientQuota)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("ResourceQuota quota"))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should create a pipelineRun if the target namespace has quota headroom left", func() {
+			os.Setenv("RELEASE_PIPELINE_QUOTA_CHECK", "true")
+			defer os.Unsetenv("RELEASE_PIPELINE_QUOTA_CHECK")
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.ResourceQuotasContextKey,
+					Resource: []corev1.ResourceQuota{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+							Status: corev1.ResourceQuotaStatus{
+								Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+								Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+							},
+						},
+					},
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should wait if the minimum interval since the previous successful release hasn't elapsed", func() {
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			adapter.clock = fakeClock
+
+			newReleaseStrategy := releaseStrategy.DeepCopy()
+			newReleaseStrategy.Spec.MinReleaseInterval = &metav1.Duration{Duration: time.Hour}
+			previousRelease := &v1alpha1.Release{
+				Status: v1alpha1.ReleaseStatus{
+					CompletionTime: &metav1.Time{Time: fakeClock.Now().Add(-time.Minute)},
+				},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   newReleaseStrategy,
+				},
+				{
+					ContextKey: loader.EnterpriseContractPolicyContextKey,
+					Resource:   enterpriseContractPolicy,
+				},
+				{
+					ContextKey: loader.PreviousSuccessfulReleaseContextKey,
+					Resource:   previousRelease,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineRunExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(59 * time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseTooSoon)))
+
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(time.Hour)
+
+			result, err = adapter.EnsureReleasePipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasStarted()).To(BeTrue())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+	})
+
+	Context("When EnsureReleasePipelineStatusIsTracked is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release hasn't started or it's done", func() {
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should track the status if the pipelineRun exists", func() {
+			adapter.release.MarkRunning()
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeTrue())
+		})
+
+		It("should requeue while the PersistentVolumeClaim backing the pipelineRun workspace is pending", func() {
+			adapter.release.MarkRunning()
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+				Spec: v1beta1.PipelineRunSpec{
+					Workspaces: []v1beta1.WorkspaceBinding{
+						{
+							Name:                  "release-workspace",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "release-pvc"},
+						},
+					},
+				},
+			}
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.PersistentVolumeClaimContextKey,
+					Resource: &corev1.PersistentVolumeClaim{
+						Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(workspaceStoragePollInterval))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsWorkspaceStorageBound()).To(BeFalse())
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "WorkspaceStorageBinding")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(v1alpha1.ReleaseReasonWorkspaceStoragePending)))
+			Expect(condition.Message).To(Equal("PersistentVolumeClaim default/release-pvc backing workspace release-workspace is pending"))
+		})
+
+		It("should mark the workspace storage as bound once the PersistentVolumeClaim is bound", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkWorkspaceStoragePending("pending")
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+				Spec: v1beta1.PipelineRunSpec{
+					Workspaces: []v1beta1.WorkspaceBinding{
+						{
+							Name:                  "release-workspace",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "release-pvc"},
+						},
+					},
+				},
+			}
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.PersistentVolumeClaimContextKey,
+					Resource: &corev1.PersistentVolumeClaim{
+						Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(getMissingPipelineRunCheckInterval()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsWorkspaceStorageBound()).To(BeTrue())
+		})
+
+		It("should register the tampering if the pipelineRun references a different pipeline", func() {
+			adapter.release.MarkRunning()
+			adapter.release.Status.Pipeline = "release-pipeline"
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+				Spec: v1beta1.PipelineRunSpec{
+					PipelineRef: &v1beta1.PipelineRef{Name: "other-pipeline"},
+				},
+			}
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			_, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPipelineRunTampered()).To(BeTrue())
+		})
+
+		It("should not register any tampering if the pipelineRun references the expected pipeline", func() {
+			adapter.release.MarkRunning()
+			adapter.release.Status.Pipeline = "release-pipeline"
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+				Spec: v1beta1.PipelineRunSpec{
+					PipelineRef: &v1beta1.PipelineRef{Name: "release-pipeline"},
+				},
+			}
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			_, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsPipelineRunTampered()).To(BeFalse())
+		})
+
+		It("should requeue after the poll interval if the pipelineRun status is not observable yet", func() {
+			adapter.release.MarkRunning()
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(getPipelineRunStatusPollInterval()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+		})
+
+		It("should requeue after the missing check interval while the pipelineRun is running", func() {
+			adapter.release.MarkRunning()
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(getMissingPipelineRunCheckInterval()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+		})
+
+		It("should continue if the pipelineRun doesn't exist", func() {
+			adapter.release.MarkRunning()
+
+			result, err := adapter.EnsureReleasePipelineStatusIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When EnsureReleaseProvenanceIsVerified is called", func() {
+		var (
+			adapter            *Adapter
+			fakeClock          *testingclock.FakeClock
+			newReleaseStrategy *v1alpha1.ReleaseStrategy
+			pipelineRun        *v1beta1.PipelineRun
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			fakeClock = testingclock.NewFakeClock(adapter.release.Status.CompletionTime.Time)
+			adapter.clock = fakeClock
+
+			newReleaseStrategy = releaseStrategy.DeepCopy()
+			newReleaseStrategy.Spec.ProvenanceGracePeriod = &metav1.Duration{Duration: 5 * time.Minute}
+			pipelineRun = &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   newReleaseStrategy,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+		})
+
+		It("should continue if the release hasn't succeeded", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should continue if the ReleaseStrategy doesn't require provenance", func() {
+			newReleaseStrategy.Spec.ProvenanceGracePeriod = nil
+
+			result, err := adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should mark the provenance as verified if the pipelineRun was signed", func() {
+			pipelineRun.Annotations = map[string]string{tekton.ChainsSignedAnnotation: "true"}
+
+			result, err := adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsProvenanceVerified()).To(BeTrue())
+		})
+
+		It("should requeue if the pipelineRun is not signed yet and the grace period hasn't elapsed", func() {
+			fakeClock.Step(time.Minute)
+
+			result, err := adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(4 * time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsProvenanceChecked()).To(BeFalse())
+		})
+
+		It("should mark the provenance as missing and stop if the grace period elapsed", func() {
+			fakeClock.Step(5 * time.Minute)
+
+			result, err := adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsProvenanceChecked()).To(BeTrue())
+			Expect(adapter.release.IsProvenanceVerified()).To(BeFalse())
+
+			result, err = adapter.EnsureReleaseProvenanceIsVerified()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When EnsureSnapshotEnvironmentBindingExists is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("skips the operation if the release has not succeeded yet", func() {
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("skips the operation if the release has already being deployed", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
+			adapter.release.MarkDeployed("", "")
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("skips the operation if no environment is set in the ReleasePlanAdmission", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			newReleasePlanAdmission := &v1alpha1.ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan-admission",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					Application:     "app",
+					Origin:          "default",
+					ReleaseStrategy: "strategy",
+				},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SnapshotEnvironmentBinding).To(BeEmpty())
+		})
+
+		It("fails when the ReleasePlanAdmission is not present", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+
+		It("updates the binding if one already exists", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					Resource:   snapshotEnvironmentBinding,
+				},
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingResourcesContextKey,
+					Resource: &loader.SnapshotEnvironmentBindingResources{
+						Application:           application,
+						ApplicationComponents: []applicationapiv1alpha1.Component{*component},
+						Environment:           environment,
+						Snapshot:              snapshot,
+					},
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SnapshotEnvironmentBinding).NotTo(BeEmpty())
+
+			binding, _ := adapter.loader.GetSnapshotEnvironmentBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
+			Expect(binding).NotTo(BeNil())
+			Expect(binding.Annotations).To(HaveLen(2))
+			Expect(binding.Annotations[handler.NamespacedNameAnnotation]).To(
+				Equal(adapter.release.Namespace + "/" + adapter.release.Name),
+			)
+			Expect(binding.Annotations[handler.TypeAnnotation]).To(Equal(adapter.release.Kind))
+		})
+
+		It("creates a binding and updates the release status", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+				},
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingResourcesContextKey,
+					Resource: &loader.SnapshotEnvironmentBindingResources{
+						Application:           application,
+						ApplicationComponents: []applicationapiv1alpha1.Component{*component},
+						Environment:           environment,
+						Snapshot:              snapshot,
+					},
+				},
+			})
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.SnapshotEnvironmentBinding).NotTo(BeEmpty())
+
+			// Restore the context to get the actual binding
+			adapter.ctx = context.TODO()
+
+			binding, err := adapter.loader.GetSnapshotEnvironmentBindingFromReleaseStatus(adapter.ctx, adapter.client, adapter.release)
+			Expect(binding).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Delete(ctx, binding)).Should(Succeed())
+		})
+	})
+
+	Context("When EnsureSnapshotEnvironmentBindingIsTracked is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("skips the operation if the release has not succeeded yet", func() {
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("skips the operation if the release doesn't have an associated binding", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("skips the operation if the release has been deployed already", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.Status.SnapshotEnvironmentBinding = snapshotEnvironmentBinding.Namespace + "/" + snapshotEnvironmentBinding.Name
+			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
+			adapter.release.MarkDeployed("", "")
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails if the binding is not found", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.Status.SnapshotEnvironmentBinding = "not/found"
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("skips the operation if the binding isn't owned by the release", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			newSnapshotEnvironmentBinding := snapshotEnvironmentBinding.DeepCopy()
+			newSnapshotEnvironmentBinding.Status.ComponentDeploymentConditions = []metav1.Condition{
+				{
+					Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+					Status: metav1.ConditionTrue,
+					Reason: "Deployed",
+				},
+			}
+			newSnapshotEnvironmentBinding.Annotations = map[string]string{
+				handler.TypeAnnotation:           adapter.release.Kind,
+				handler.NamespacedNameAnnotation: "other-release",
+			}
+			adapter.release.Status.SnapshotEnvironmentBinding = newSnapshotEnvironmentBinding.Namespace + "/" + newSnapshotEnvironmentBinding.Name
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("tracks the binding if one is found", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
+					Resource:   snapshotEnvironmentBinding,
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.Status.SnapshotEnvironmentBinding = snapshotEnvironmentBinding.Namespace + "/" + snapshotEnvironmentBinding.Name
+
+			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When EnsureCollectorsPipelineRunExists is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.Spec.Collectors = []v1alpha1.Collector{
+				{Name: "sbom", Task: "collect-sbom"},
+			}
+		})
+
+		It("skips the operation if the release has not succeeded yet", func() {
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(BeEmpty())
+		})
+
+		It("skips the operation if the release doesn't have collectors", func() {
+			adapter.release.Spec.Collectors = nil
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(BeEmpty())
+			Expect(adapter.release.IsCollectingMetadata()).To(BeFalse())
+		})
+
+		It("fails the metadata collection if the release PipelineRun no longer exists", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(BeEmpty())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+		})
+
+		It("fails the metadata collection if a collector bundle is not hosted in an allowed registry", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io")
+			defer os.Unsetenv("ALLOWED_BUNDLE_REGISTRIES")
+			adapter.release.Spec.Collectors[0].Bundle = "example.com/collectors:latest"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "managed",
+						},
+					},
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(BeEmpty())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetCollectorsPipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).To(BeNil())
+		})
+
+		It("fails the metadata collection if the collectors PipelineRun is not valid", func() {
+			adapter.release.Spec.Collectors = append(adapter.release.Spec.Collectors,
+				v1alpha1.Collector{Name: "sbom", Task: "collect-other-sbom"})
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "managed",
+						},
+					},
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(BeEmpty())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+			Expect(meta.FindStatusCondition(adapter.release.Status.Conditions, "MetadataCollected").Message).To(
+				ContainSubstring("the collectors PipelineRun is not valid"))
+		})
+
+		It("creates the collectors PipelineRun in the release namespace with its default service account", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "managed",
+							Labels: map[string]string{
+								tekton.ApplicationNameLabel: application.Name,
+							},
+						},
+						Spec: v1beta1.PipelineRunSpec{
+							ServiceAccountName: "release-service-account",
+						},
+					},
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).NotTo(BeEmpty())
+			Expect(adapter.release.IsCollectingMetadata()).To(BeTrue())
+
+			pipelineRun, err := adapter.loader.GetCollectorsPipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Namespace).To(Equal(adapter.release.Namespace))
+			Expect(pipelineRun.Spec.ServiceAccountName).To(BeEmpty())
+			Expect(pipelineRun.Spec.PipelineSpec.Tasks).To(HaveLen(1))
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(Equal(pipelineRun.Namespace + "/" + pipelineRun.Name))
+
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("tracks an existing collectors PipelineRun", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.CollectorsPipelineRunContextKey,
+					Resource: &v1beta1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "collectors-pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+			})
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+
+			result, err := adapter.EnsureCollectorsPipelineRunExists()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.CollectorsPipelineRun).To(Equal("default/collectors-pipeline-run"))
+			Expect(adapter.release.IsCollectingMetadata()).To(BeTrue())
+		})
+	})
+
+	Context("When EnsureCollectorsPipelineRunIsTracked is called", func() {
+		var (
+			adapter     *Adapter
+			pipelineRun *v1beta1.PipelineRun
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			adapter.release.Status.CollectorsPipelineRun = "default/collectors-pipeline-run"
+			adapter.release.MarkCollectingMetadata()
+
+			pipelineRun = &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "collectors-pipeline-run",
+					Namespace: "default",
+				},
+			}
+		})
+
+		It("skips the operation if the release is not collecting metadata", func() {
+			adapter.release.MarkMetadataCollected()
+
+			result, err := adapter.EnsureCollectorsPipelineRunIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails the metadata collection if the collectors PipelineRun no longer exists", func() {
+			result, err := adapter.EnsureCollectorsPipelineRunIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+		})
+
+		It("keeps collecting metadata while the collectors PipelineRun is running", func() {
+			pipelineRun.Status.MarkRunning("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.CollectorsPipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureCollectorsPipelineRunIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsCollectingMetadata()).To(BeTrue())
+		})
+
+		It("fails the metadata collection if the collectors PipelineRun failed", func() {
+			pipelineRun.Status.MarkFailed("", "collector failed")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.CollectorsPipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureCollectorsPipelineRunIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+			Expect(adapter.release.Status.CollectorResults).To(BeEmpty())
+		})
+
+		It("records the collector results once the collectors PipelineRun succeeds", func() {
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.CollectorsPipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunTaskRunsContextKey,
+					Resource: []v1beta1.TaskRun{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"tekton.dev/pipelineTask": "sbom"},
+							},
+							Status: v1beta1.TaskRunStatus{
+								TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+									TaskRunResults: []v1beta1.TaskRunResult{
+										{Name: "url", Value: *v1beta1.NewStructuredValues("https://sbom")},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureCollectorsPipelineRunIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsMetadataCollectionDone()).To(BeTrue())
+			Expect(adapter.release.Status.CollectorResults).To(Equal([]v1alpha1.CollectorResult{
+				{Name: "sbom", Results: map[string]string{"url": "https://sbom"}},
+			}))
+		})
+	})
+
+	Context("When EnsureReleaseIsApproved is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release has no approvers", func() {
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should stop reconcile and mark the release as waiting if it wasn't approved", func() {
+			adapter.release.Spec.Approvers = []string{"alice", "bob"}
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForApproval)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring("alice, bob"))
+		})
+
+		It("should stop reconcile if the release was approved by someone who is not an approver", func() {
+			adapter.release.Spec.Approvers = []string{"alice"}
+			adapter.release.SetAnnotations(map[string]string{v1alpha1.ApprovedByAnnotation: "bob"})
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonWaitingForApproval)))
+		})
+
+		It("should continue if the release was approved by one of its approvers", func() {
+			adapter.release.Spec.Approvers = []string{"alice"}
+			adapter.release.SetAnnotations(map[string]string{v1alpha1.ApprovedByAnnotation: "alice"})
+
+			result, err := adapter.EnsureReleaseIsApproved()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Context("When EnsureReleaseStrategyIsAllowed is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("RELEASE_STRATEGY_SHARED_NAMESPACES")
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release doesn't reference a strategy", func() {
+			result, err := adapter.EnsureReleaseStrategyIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should stop reconcile if the strategy is in the release namespace and it's not a shared namespace", func() {
+			adapter.release.Spec.ReleaseStrategy = "default/strategy"
+
+			result, err := adapter.EnsureReleaseStrategyIsAllowed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseStrategyNotAllowed)))
+		})
+
+		It("should continue if the strategy is in an allowed shared namespace", func() {
+			os.Setenv("RELEASE_STRATEGY_SHARED_NAMESPACES", "shared")
+			adapter.release.Spec.ReleaseStrategy = "shared/strategy"
+
+			result, err := adapter.EnsureReleaseStrategyIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should stop reconcile if the strategy is in a namespace that is not allowed", func() {
+			os.Setenv("RELEASE_STRATEGY_SHARED_NAMESPACES", "shared")
+			adapter.release.Spec.ReleaseStrategy = "other/strategy"
+
+			result, err := adapter.EnsureReleaseStrategyIsAllowed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonReleaseStrategyNotAllowed)))
+		})
+
+		It("should continue if the release is being deleted", func() {
+			adapter.release.Spec.ReleaseStrategy = "other/strategy"
+			adapter.release.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+			result, err := adapter.EnsureReleaseStrategyIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Context("When EnsureTargetOverrideIsAllowed is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("ALLOW_TARGET_OVERRIDE")
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the release doesn't override its target", func() {
+			result, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.TargetOverridden).To(BeFalse())
+		})
+
+		It("should continue if the release is being deleted", func() {
+			adapter.release.Spec.OverrideTarget = "override"
+			adapter.release.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+			result, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should stop reconcile if target overrides are not allowed in the controller", func() {
+			adapter.release.Spec.OverrideTarget = "override"
+			adapter.release.Labels = map[string]string{v1alpha1.OverrideTargetLabel: "true"}
+
+			result, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetOverrideNotAllowed)))
+			Expect(adapter.release.Status.TargetOverridden).To(BeFalse())
+		})
+
+		It("should stop reconcile if the release doesn't have the override-target label", func() {
+			os.Setenv("ALLOW_TARGET_OVERRIDE", "true")
+			adapter.release.Spec.OverrideTarget = "override"
+
+			result, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonTargetOverrideNotAllowed)))
+			Expect(adapter.release.Status.Conditions[0].Message).To(ContainSubstring(v1alpha1.OverrideTargetLabel))
+		})
+
+		It("should record the override and continue if the override is allowed", func() {
+			os.Setenv("ALLOW_TARGET_OVERRIDE", "true")
+			adapter.release.Spec.OverrideTarget = "override"
+			adapter.release.Labels = map[string]string{v1alpha1.OverrideTargetLabel: "true"}
+
+			result, err := adapter.EnsureTargetOverrideIsAllowed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Conditions).To(BeEmpty())
+			Expect(adapter.release.Status.TargetOverridden).To(BeTrue())
+		})
+	})
+
+	Context("When getActiveReleasePlanAdmission is called", func() {
+		var adapter *Adapter
+
+		strictMiss := loader.MockData{
+			ContextKey: loader.ReleasePlanAdmissionContextKey,
+			Err:        fmt.Errorf("no ReleasePlanAdmission found in the target (foo) for application 'app'"),
+		}
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("ENABLE_TARGET_FALLBACK")
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("returns the release plan admission matching the target", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			returnedReleasePlanAdmission, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleasePlanAdmission).To(Equal(releasePlanAdmission))
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeFalse())
+		})
+
+		It("returns the strict match error if the fallback is disabled", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				strictMiss,
+				{
+					ContextKey: loader.ReleasePlanAdmissionByApplicationContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			returnedReleasePlanAdmission, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).To(HaveOccurred())
+			Expect(returnedReleasePlanAdmission).To(BeNil())
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeFalse())
+		})
+
+		It("falls back to matching the application alone if the strict match fails", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				strictMiss,
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionByApplicationContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			returnedReleasePlanAdmission, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleasePlanAdmission).To(Equal(releasePlanAdmission))
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeTrue())
+		})
+
+		It("falls back to matching the application alone if the target namespace doesn't exist", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("target namespace (foo) not found for application 'app'"),
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionByApplicationContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			returnedReleasePlanAdmission, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleasePlanAdmission).To(Equal(releasePlanAdmission))
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeTrue())
+		})
+
+		It("returns the strict match error if the fallback doesn't find a match either", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				strictMiss,
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionByApplicationContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found for application 'app'"),
+				},
+			})
+
+			_, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).To(Equal(strictMiss.Err))
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeFalse())
+		})
+
+		It("doesn't fall back if the release overrides its target", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			adapter.release.Spec.OverrideTarget = "foo"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				strictMiss,
+				{
+					ContextKey: loader.ReleasePlanAdmissionByApplicationContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+
+			_, err := adapter.getActiveReleasePlanAdmission()
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.IsMatchedViaFallback()).To(BeFalse())
+		})
+	})
+
+	Context("When getReleaseStrategy is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("returns the strategy referenced by the ReleasePlanAdmission if the release doesn't reference one", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   releaseStrategy,
+				},
+			})
+
+			returnedReleaseStrategy, err := adapter.getReleaseStrategy(releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleaseStrategy).To(Equal(releaseStrategy))
+		})
+
+		It("returns the strategy referenced by the release", func() {
+			directReleaseStrategy := releaseStrategy.DeepCopy()
+			directReleaseStrategy.Name = "direct-strategy"
+			adapter.release.Spec.ReleaseStrategy = "default/direct-strategy"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   releaseStrategy,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyByNameContextKey,
+					Resource:   directReleaseStrategy,
+				},
+			})
+
+			returnedReleaseStrategy, err := adapter.getReleaseStrategy(releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleaseStrategy).To(Equal(directReleaseStrategy))
+		})
+
+		It("returns a descriptive error if the ReleasePlanAdmission references a missing strategy", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, releasePlanAdmission.Spec.ReleaseStrategy),
+				},
+			})
+
+			_, err := adapter.getReleaseStrategy(releasePlanAdmission)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(err.Error()).To(HavePrefix(fmt.Sprintf("ReleasePlanAdmission %s/%s references missing ReleaseStrategy %s/%s",
+				releasePlanAdmission.Namespace, releasePlanAdmission.Name,
+				releasePlanAdmission.Namespace, releasePlanAdmission.Spec.ReleaseStrategy)))
+		})
+
+		It("returns a descriptive error if the release references a missing strategy", func() {
+			adapter.release.Spec.ReleaseStrategy = "shared/missing-strategy"
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseStrategyByNameContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, "missing-strategy"),
+				},
+			})
+
+			_, err := adapter.getReleaseStrategy(releasePlanAdmission)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(err.Error()).To(HavePrefix(fmt.Sprintf("Release %s/%s references missing ReleaseStrategy shared/missing-strategy",
+				adapter.release.Namespace, adapter.release.Name)))
+		})
+
+		It("returns the strategy stored in the artifact referenced by the ReleasePlanAdmission", func() {
+			adapter.strategyResolver = oci.NewReleaseStrategyResolver(&fakeArtifactResolver{
+				content: []byte(`{"kind": "ReleaseStrategy", "metadata": {"name": "artifact-strategy"}, "spec": {"pipeline": "release-pipeline"}}`),
+			})
+			artifactReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			artifactReleasePlanAdmission.Spec.ReleaseStrategyArtifact = "quay.io/redhat-appstudio/release-strategy:latest"
+
+			returnedReleaseStrategy, err := adapter.getReleaseStrategy(artifactReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedReleaseStrategy.Name).To(Equal("artifact-strategy"))
+			Expect(returnedReleaseStrategy.Namespace).To(Equal(artifactReleasePlanAdmission.Namespace))
+			Expect(returnedReleaseStrategy.Spec.Pipeline).To(Equal("release-pipeline"))
+		})
+
+		It("returns an error if the ReleasePlanAdmission references an artifact but no resolver is set", func() {
+			artifactReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			artifactReleasePlanAdmission.Spec.ReleaseStrategyArtifact = "quay.io/redhat-appstudio/release-strategy:latest"
+
+			_, err := adapter.getReleaseStrategy(artifactReleasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error if the artifact referenced by the ReleasePlanAdmission can't be parsed", func() {
+			adapter.strategyResolver = oci.NewReleaseStrategyResolver(&fakeArtifactResolver{content: []byte("invalid")})
+			artifactReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			artifactReleasePlanAdmission.Spec.ReleaseStrategyArtifact = "quay.io/redhat-appstudio/release-strategy:latest"
+
+			_, err := adapter.getReleaseStrategy(artifactReleasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to parse the ReleaseStrategy artifact"))
+		})
+	})
+
+	Context("When newReleasePipelineRun is called", func() {
+		var (
+			adapter     *Adapter
+			pipelineRun *v1beta1.PipelineRun
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+
+			pipelineRun = adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+		})
+
+		It("returns a PipelineRun", func() {
+			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&v1beta1.PipelineRun{})))
+		})
+
+		It("has owner annotations", func() {
+			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
+			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+		})
+
+		It("has a name unique to the Release", func() {
+			Expect(pipelineRun.Name).To(Equal(getReleasePipelineRunName(adapter.release)))
+			Expect(pipelineRun.GenerateName).To(BeEmpty())
+		})
+
+		It("has release labels", func() {
+			Expect(pipelineRun.GetLabels()[tekton.PipelinesTypeLabel]).To(Equal("release"))
+			Expect(pipelineRun.GetLabels()[tekton.ReleaseNameLabel]).To(Equal(adapter.release.Name))
+			Expect(pipelineRun.GetLabels()[tekton.ReleaseNamespaceLabel]).To(Equal(testNamespace))
+		})
+
+		It("references the pipeline specified in the ReleaseStrategy", func() {
+			Expect(pipelineRun.Spec.PipelineRef.Name).To(Equal(releaseStrategy.Spec.Pipeline))
+		})
+
+		It("contains a parameter with the json representation of the EnterpriseContractPolicy", func() {
+			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+		})
+
+		It("contains a parameter with the json representation of the Snapshot", func() {
+			jsonSpec, _ := json.Marshal(snapshot.Spec)
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+		})
+
+		It("runs the tasks with the service accounts set in the ReleaseStrategy", func() {
+			serviceAccountsReleaseStrategy := releaseStrategy.DeepCopy()
+			serviceAccountsReleaseStrategy.Spec.PipelineServiceAccountPerTask = map[string]string{"push": "registry-pusher"}
+			pipelineRun = adapter.newReleasePipelineRun(releasePlanAdmission, serviceAccountsReleaseStrategy,
+				enterpriseContractPolicy, snapshot)
+			Expect(pipelineRun.Spec.TaskRunSpecs).To(Equal([]v1beta1.PipelineTaskRunSpec{
+				{PipelineTaskName: "push", TaskServiceAccountName: "registry-pusher"},
+			}))
+		})
+
+		It("contains a parameter with the json representation of the Release env", func() {
+			adapter.release.Spec.Env = map[string]string{"tier": "prod"}
+			pipelineRun = adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			Expect(pipelineRun.Spec.Params).To(ContainElement(And(
+				HaveField("Name", Equal(tekton.EnvParamName)),
+				HaveField("Value.StringVal", Equal(`{"tier":"prod"}`)),
+			)))
+		})
+
+		It("mounts the ReleaseStrategy service account token without exposing it", func() {
+			tokenReleaseStrategy := releaseStrategy.DeepCopy()
+			tokenReleaseStrategy.Spec.ServiceAccountToken = &v1alpha1.ServiceAccountToken{
+				Workspace: "registry-token",
+				Audience:  "registry",
+			}
+			pipelineRun = adapter.newReleasePipelineRun(releasePlanAdmission, tokenReleaseStrategy, enterpriseContractPolicy, snapshot)
+			Expect(pipelineRun.Spec.Workspaces).To(ContainElement(And(
+				HaveField("Name", Equal("registry-token")),
+				HaveField("Secret", BeNil()),
+				HaveField("Projected.Sources", ContainElement(
+					HaveField("ServiceAccountToken.Audience", Equal("registry")))),
+			)))
+			Expect(pipelineRun.Spec.Params).NotTo(ContainElement(HaveField("Name", Equal("registry-token"))))
+
+			Expect(adapter.registerReleaseStatusData(pipelineRun, tokenReleaseStrategy)).To(Succeed())
+			status, err := json.Marshal(adapter.release.Status)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(status)).NotTo(ContainSubstring("registry-token"))
+		})
+	})
+
+	Context("When setReleaseAsControllerOwner is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("sets the Release as the controller owner of a PipelineRun in the same namespace", func() {
+			pipelineRun := &v1beta1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Namespace: adapter.release.Namespace}}
+			Expect(adapter.setReleaseAsControllerOwner(pipelineRun)).To(Succeed())
+			Expect(metav1.IsControlledBy(pipelineRun, adapter.release)).To(BeTrue())
+		})
+
+		It("leaves PipelineRuns in other namespaces without owner references", func() {
+			pipelineRun := &v1beta1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Namespace: "managed"}}
+			Expect(adapter.setReleaseAsControllerOwner(pipelineRun)).To(Succeed())
+			Expect(pipelineRun.OwnerReferences).To(BeEmpty())
+		})
+	})
+
+	Context("When registerGitOpsDeploymentStatus is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no binding", func() {
+			adapter.registerGitOpsDeploymentStatus(nil)
+			Expect(adapter.release.IsDeploying()).To(BeFalse())
+		})
+
+		It("does nothing if the binding doesn't have the expected condition", func() {
+			adapter.registerGitOpsDeploymentStatus(snapshotEnvironmentBinding)
+			Expect(adapter.release.IsDeploying()).To(BeFalse())
+		})
+
+		It("registers the deployment status when the binding has been deployed", func() {
+			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
+			newSnapshotEnvironmentBinding := snapshotEnvironmentBinding.DeepCopy()
+			newSnapshotEnvironmentBinding.Status.ComponentDeploymentConditions = []metav1.Condition{
+				{
+					Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+					Status: metav1.ConditionTrue,
+					Reason: "Deployed",
+				},
+			}
+			adapter.registerGitOpsDeploymentStatus(newSnapshotEnvironmentBinding)
+			Expect(adapter.release.IsDeployed()).To(BeTrue())
+		})
+	})
+
+	Context("When registerReleaseOutcomeLabel is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if the Release is not done", func() {
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+			Expect(adapter.release.Labels).NotTo(HaveKey(v1alpha1.OutcomeLabel))
+		})
+
+		It("stamps the succeeded outcome if the Release succeeded", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Labels).To(HaveKeyWithValue(v1alpha1.OutcomeLabel, v1alpha1.OutcomeLabelSucceeded))
+		})
+
+		It("stamps the failed outcome if the Release failed", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Labels).To(HaveKeyWithValue(v1alpha1.OutcomeLabel, v1alpha1.OutcomeLabelFailed))
+		})
+
+		It("updates the outcome if it changes", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+			Expect(adapter.release.Labels).To(HaveKeyWithValue(v1alpha1.OutcomeLabel, v1alpha1.OutcomeLabelFailed))
+
+			// Simulate a retry of the Release
+			adapter.release.Status = v1alpha1.ReleaseStatus{}
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+			Expect(adapter.release.Labels).To(HaveKeyWithValue(v1alpha1.OutcomeLabel, v1alpha1.OutcomeLabelSucceeded))
+		})
+
+		It("removes the outcome if the Release is no longer done", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+
+			adapter.release.Status = v1alpha1.ReleaseStatus{}
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+			Expect(adapter.release.Labels).NotTo(HaveKey(v1alpha1.OutcomeLabel))
+		})
+
+		It("preserves the pending status changes", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			Expect(adapter.registerReleaseOutcomeLabel()).To(Succeed())
+			Expect(adapter.release.HasSucceeded()).To(BeTrue())
+		})
+	})
+
+	Context("When registerReleasePipelineRunFailureLog is called", func() {
+		var (
+			adapter   *Adapter
+			logSource *fakeLogSource
+		)
+
+		failedTaskRun := v1beta1.TaskRun{}
+		failedTaskRun.Status.MarkResourceFailed("Failed", fmt.Errorf("step failed"))
+		failedTaskRun.Status.PodName = "failed-pod"
+		failedTaskRun.Status.Steps = []v1beta1.StepState{
+			{
+				ContainerState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+				},
+				ContainerName: "step-release",
+			},
+		}
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			os.Unsetenv("RELEASE_FAILURE_LOG_LINES")
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			logSource = &fakeLogSource{logs: "error: unable to push the image"}
+			adapter.logSource = logSource
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunTaskRunsContextKey,
+					Resource:   []v1beta1.TaskRun{failedTaskRun},
+				},
+			})
+		})
+
+		It("stores the logs of the failed step in the Release status", func() {
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(Equal("error: unable to push the image"))
+			Expect(logSource.podName).To(Equal("failed-pod"))
+			Expect(logSource.containerName).To(Equal("step-release"))
+		})
+
+		It("truncates the logs to the maximum size", func() {
+			logSource.logs = strings.Repeat("a", maxFailureLogSize) + "error"
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(HaveLen(maxFailureLogSize))
+			Expect(adapter.release.Status.FailureLog).To(HaveSuffix("error"))
+		})
+
+		It("does nothing if there is no log source", func() {
+			adapter.logSource = nil
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(BeEmpty())
+		})
+
+		It("does nothing if capturing the logs is disabled", func() {
+			os.Setenv("RELEASE_FAILURE_LOG_LINES", "0")
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(BeEmpty())
+		})
+
+		It("does nothing if no step failed", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunTaskRunsContextKey,
+					Resource:   []v1beta1.TaskRun{},
+				},
+			})
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(BeEmpty())
+		})
+
+		It("leaves the Release status untouched if the TaskRuns can't be loaded", func() {
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunTaskRunsContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			})
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(BeEmpty())
+		})
+
+		It("leaves the Release status untouched if the logs can't be fetched", func() {
+			logSource.err = fmt.Errorf("pod not found")
+			adapter.registerReleasePipelineRunFailureLog(&v1beta1.PipelineRun{})
+			Expect(adapter.release.Status.FailureLog).To(BeEmpty())
+		})
+
+		It("stores the logs of the failed step when the release PipelineRun fails", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.FailureLog).To(Equal("error: unable to push the image"))
+		})
+	})
+
+	Context("When registerReleasePipelineRunResultsOutput is called", func() {
+		var (
+			adapter     *Adapter
+			pipelineRun *v1beta1.PipelineRun
+		)
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "results", Namespace: "default"}})
+			_ = k8sClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "results", Namespace: "default"}})
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			pipelineRun = &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{
+					Name:  "image-digest",
+					Value: *v1beta1.NewStructuredValues("sha256:abc"),
+				},
+				{
+					Name:  "image-tags",
+					Value: *v1beta1.NewStructuredValues("latest", "v1"),
+				},
+			}
+		})
+
+		It("does nothing if the Release doesn't set a resultsOutput", func() {
+			written, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(BeTrue())
+		})
+
+		It("writes the results to a ConfigMap owned by the Release", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindConfigMap,
+				Name: "results",
+			}
+			written, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(BeTrue())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "results", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"image-digest": "sha256:abc",
+				"image-tags":   `["latest","v1"]`,
+			}))
+			Expect(metav1.IsControlledBy(configMap, adapter.release)).To(BeTrue())
+		})
+
+		It("writes the results to a Secret owned by the Release", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindSecret,
+				Name: "results",
+			}
+			written, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(BeTrue())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "results", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(secret.Data).To(HaveKeyWithValue("image-digest", []byte("sha256:abc")))
+			Expect(metav1.IsControlledBy(secret, adapter.release)).To(BeTrue())
+		})
+
+		It("replaces the content of an existing resource owned by the Release", func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "results", Namespace: "default"},
+				Data:       map[string]string{"stale": "value"},
+			}
+			Expect(ctrl.SetControllerReference(adapter.release, configMap, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindConfigMap,
+				Name: "results",
+			}
+			written, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(BeTrue())
+
+			configMap = &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "results", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).NotTo(HaveKey("stale"))
+			Expect(configMap.Data).To(HaveKeyWithValue("image-digest", "sha256:abc"))
+			Expect(metav1.IsControlledBy(configMap, adapter.release)).To(BeTrue())
+		})
+
+		It("leaves an existing Secret that isn't owned by the Release untouched", func() {
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "results", Namespace: "default"},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			})).To(Succeed())
+
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindSecret,
+				Name: "results",
+			}
+			written, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(BeFalse())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "results", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"token": []byte("secret")}))
+			Expect(secret.OwnerReferences).To(BeEmpty())
+		})
+
+		It("returns an error if the resultsOutput kind is not supported", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: "Pod",
+				Name: "results",
+			}
+			_, err := adapter.registerReleasePipelineRunResultsOutput(pipelineRun)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When registerReleasePipelineRunStatus is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerReleasePipelineRunStatus(nil)).To(Succeed())
+			Expect(adapter.release.Status.CompletionTime).To(BeNil())
+		})
+
+		It("does nothing if the PipelineRun is not done", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.CompletionTime).To(BeNil())
+		})
+
+		It("keeps the Release start time if the PipelineRun hasn't reported its start time", func() {
+			adapter.release.MarkRunning()
+			startTime := adapter.release.Status.StartTime.DeepCopy()
+
+			Expect(adapter.registerReleasePipelineRunStatus(&v1beta1.PipelineRun{})).To(Succeed())
+			Expect(adapter.release.Status.StartTime).To(Equal(startTime))
+		})
+
+		It("sets the Release start time from the PipelineRun when it was delayed", func() {
+			adapter.release.MarkRunning()
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.StartTime = &metav1.Time{Time: adapter.release.CreationTimestamp.Add(10 * time.Minute)}
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.StartTime.Time).To(Equal(pipelineRun.Status.StartTime.Time))
+			Expect(adapter.release.Status.StartTime.Time).NotTo(Equal(adapter.release.CreationTimestamp.Time))
+		})
+
+		It("sets the Release completion time", func() {
+			fakeClock := testingclock.NewFakeClock(time.Now().Add(-time.Hour))
+			adapter.clock = fakeClock
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasSucceeded()).To(BeTrue())
+			Expect(adapter.release.Status.CompletionTime).NotTo(BeNil())
+			Expect(adapter.release.Status.CompletionTime.Time).To(Equal(fakeClock.Now()))
+		})
+
+		It("sets the Release as succeeded if the PipelineRun succeeded", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasSucceeded()).To(BeTrue())
+		})
+
+		It("sets the Release as failed if the PipelineRun didn't succeed", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasSucceeded()).To(BeFalse())
+		})
+
+		It("copies the PipelineRun status message verbatim while it's running", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkRunning("Running", "Tasks Completed: 1 (Failed: 0, Cancelled 0), Incomplete: 2, Skipped: 0")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Message).To(
+				Equal("Tasks Completed: 1 (Failed: 0, Cancelled 0), Incomplete: 2, Skipped: 0"))
+
+			pipelineRun.Status.MarkRunning("Running", "Tasks Completed: 2 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0")
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Conditions[0].Message).To(
+				Equal("Tasks Completed: 2 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0"))
+		})
+
+		It("copies the results of a succeeded PipelineRun to the Release status", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: imageDigestResult, Value: *v1beta1.NewStructuredValues("sha256:abc")},
+				{Name: "IMAGE_URL", Value: *v1beta1.NewStructuredValues("quay.io/redhat-appstudio/image")},
+			}
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Results).To(Equal([]v1alpha1.ReleaseResult{
+				{Name: imageDigestResult, Value: "sha256:abc"},
+				{Name: "IMAGE_URL", Value: "quay.io/redhat-appstudio/image"},
+			}))
+		})
+
+		It("doesn't copy the results of a failed PipelineRun to the Release status", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: imageDigestResult, Value: *v1beta1.NewStructuredValues("sha256:abc")},
+			}
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Results).To(BeEmpty())
+		})
+
+		It("doesn't copy the results to the Release status if they are written to a Secret", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindSecret,
+				Name: "sensitive-results",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: "token", Value: *v1beta1.NewStructuredValues("secret-value")},
+			}
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Results).To(BeEmpty())
+
+			Expect(k8sClient.Delete(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "sensitive-results", Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("records a completion event with the structured outcome of a succeeded Release", func() {
+			recorder := &fakeEventRecorder{}
+			adapter.recorder = recorder
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("Succeeded", "Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0")
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: imageDigestResult, Value: *v1beta1.NewStructuredValues("sha256:abc")},
+			}
+			adapter.release.MarkRunning()
+			adapter.release.Status.ReleasePipelineRun = "target/release-pipelinerun"
+			adapter.release.Status.Target = "target"
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+
+			Expect(recorder.events).To(Equal([]string{
+				"Normal Succeeded Release succeeded: Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0",
+			}))
+			Expect(recorder.annotations).To(HaveLen(1))
+			Expect(recorder.annotations[0]).To(HaveKeyWithValue(v1alpha1.CompletionEventOutcomeAnnotation, v1alpha1.OutcomeLabelSucceeded))
+			Expect(recorder.annotations[0]).To(HaveKeyWithValue(v1alpha1.CompletionEventDigestAnnotation, "sha256:abc"))
+			Expect(recorder.annotations[0]).To(HaveKeyWithValue(v1alpha1.CompletionEventPipelineRunAnnotation, "target/release-pipelinerun"))
+			Expect(recorder.annotations[0]).To(HaveKeyWithValue(v1alpha1.CompletionEventTargetAnnotation, "target"))
+			Expect(recorder.annotations[0]).To(HaveKey(v1alpha1.CompletionEventDurationAnnotation))
+		})
+
+		It("records a warning completion event for a failed Release", func() {
+			recorder := &fakeEventRecorder{}
+			adapter.recorder = recorder
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("Failed", "Tasks Completed: 1 (Failed: 1, Cancelled 0), Skipped: 0")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+
+			Expect(recorder.events).To(HaveLen(1))
+			Expect(recorder.events[0]).To(HavePrefix("Warning " + string(v1alpha1.ReleaseReasonPipelineFailed) + " Release failed"))
+			Expect(recorder.annotations[0]).To(HaveKeyWithValue(v1alpha1.CompletionEventOutcomeAnnotation, v1alpha1.OutcomeLabelFailed))
+			Expect(recorder.annotations[0]).NotTo(HaveKey(v1alpha1.CompletionEventDigestAnnotation))
+		})
+
+		It("doesn't record a completion event while the PipelineRun is running", func() {
+			recorder := &fakeEventRecorder{}
+			adapter.recorder = recorder
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkRunning("Running", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(recorder.events).To(BeEmpty())
+		})
+
+		It("copies the PipelineRun status message verbatim once it succeeds", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("Succeeded", "Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasSucceeded()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions[0].Message).To(
+				Equal("Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1"))
+		})
+
+		It("marks the Release as paused if the PipelineRun was paused externally", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Spec.Status = v1beta1.PipelineRunSpecStatusPending
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonPipelinePaused)))
+			Expect(pipelineRun.Spec.Status).To(Equal(v1beta1.PipelineRunSpecStatus(v1beta1.PipelineRunSpecStatusPending)))
+		})
+
+		It("marks the Release as running again once the PipelineRun is resumed", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Spec.Status = v1beta1.PipelineRunSpecStatusPending
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+
+			pipelineRun.Spec.Status = ""
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonRunning)))
+		})
+
+		It("marks the Release as cancelling if the PipelineRun is being cancelled externally", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Spec.Status = v1beta1.PipelineRunSpecStatusCancelledRunFinally
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions).To(HaveLen(1))
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonPipelineCancelling)))
+		})
+
+		It("sets the Release as cancelled if the PipelineRun finished after being cancelled externally", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Spec.Status = v1beta1.PipelineRunSpecStatusCancelled
+			pipelineRun.Status.MarkFailed("Cancelled", "cancelled by user")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsDone()).To(BeTrue())
+			Expect(adapter.release.HasSucceeded()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonPipelineCancelled)))
+		})
+
+		It("stamps the PipelineRun results as Release labels if the PipelineRun succeeded", func() {
+			adapter.release.Spec.LabelsToResults = map[string]string{
+				"release.appstudio.openshift.io/digest": "image-digest",
+				"release.appstudio.openshift.io/other":  "non-existent",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{
+					Name:  "image-digest",
+					Value: *v1beta1.NewStructuredValues("sha256:abc"),
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Labels).To(HaveKeyWithValue("release.appstudio.openshift.io/digest", "sha256-abc"))
+			Expect(adapter.release.Labels).NotTo(HaveKey("release.appstudio.openshift.io/other"))
 		})
 
-		It("skips the operation if the release has been deployed already", func() {
+		It("records the transitions of the PipelineRun reason in the Release status", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkRunning("Running", "")
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			pipelineRun.Status.MarkSucceeded("Succeeded", "")
 			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-			adapter.release.Status.SnapshotEnvironmentBinding = snapshotEnvironmentBinding.Namespace + "/" + snapshotEnvironmentBinding.Name
-			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
-			adapter.release.MarkDeployed("", "")
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ReasonHistory).To(HaveLen(2))
+			Expect(adapter.release.Status.ReasonHistory[0].Reason).To(Equal("Running"))
+			Expect(adapter.release.Status.ReasonHistory[1].Reason).To(Equal("Succeeded"))
+			Expect(adapter.release.Status.ReasonHistory[1].Time.IsZero()).To(BeFalse())
 		})
 
-		It("fails if the binding is not found", func() {
+		It("writes the PipelineRun results to the resultsOutput if the PipelineRun succeeded", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindConfigMap,
+				Name: "status-results",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{
+					Name:  "image-digest",
+					Value: *v1beta1.NewStructuredValues("sha256:abc"),
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
 			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-			adapter.release.Status.SnapshotEnvironmentBinding = "not/found"
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
-			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).To(HaveOccurred())
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "status-results", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("image-digest", "sha256:abc"))
+			Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
 		})
 
-		It("skips the operation if the binding isn't owned by the release", func() {
+		It("marks the Release as failed if the resultsOutput isn't owned by the Release", func() {
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "foreign-results", Namespace: "default"},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			})).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foreign-results", Namespace: "default"}})
+			}()
+
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: v1alpha1.ResultsOutputKindSecret,
+				Name: "foreign-results",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
 			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsDone()).To(BeTrue())
+			Expect(adapter.release.HasSucceeded()).To(BeFalse())
+			Expect(adapter.release.Status.Conditions[0].Reason).To(Equal(string(v1alpha1.ReleaseReasonResultsOutputConflict)))
+		})
 
-			newSnapshotEnvironmentBinding := snapshotEnvironmentBinding.DeepCopy()
-			newSnapshotEnvironmentBinding.Status.ComponentDeploymentConditions = []metav1.Condition{
+		It("doesn't mark the Release as finished if the PipelineRun results can't be stamped as labels", func() {
+			adapter.release.Spec.LabelsToResults = map[string]string{
+				"release.appstudio.openshift.io/digest": "image-digest",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
 				{
-					Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
-					Status: metav1.ConditionTrue,
-					Reason: "Deployed",
+					Name:  "image-digest",
+					Value: *v1beta1.NewStructuredValues("sha256:abc"),
 				},
 			}
-			newSnapshotEnvironmentBinding.Annotations = map[string]string{
-				handler.TypeAnnotation:           adapter.release.Kind,
-				handler.NamespacedNameAnnotation: "other-release",
-			}
-			adapter.release.Status.SnapshotEnvironmentBinding = newSnapshotEnvironmentBinding.Namespace + "/" + newSnapshotEnvironmentBinding.Name
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkRunning()
 
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			// Patching the labels of a Release that no longer exists fails
+			Expect(k8sClient.Delete(ctx, adapter.release)).To(Succeed())
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(adapter.release), &v1alpha1.Release{}))
+			}).Should(BeTrue())
+
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).NotTo(Succeed())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.CompletionTime).To(BeNil())
 		})
 
-		It("tracks the binding if one is found", func() {
-			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
-				{
-					ContextKey: loader.SnapshotEnvironmentBindingContextKey,
-					Resource:   snapshotEnvironmentBinding,
-				},
-			})
+		It("doesn't mark the Release as finished if the PipelineRun results can't be registered", func() {
+			adapter.release.Spec.ResultsOutput = &v1alpha1.ResultsOutput{
+				Kind: "Pod",
+				Name: "status-results",
+			}
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
 			adapter.release.MarkRunning()
-			adapter.release.MarkSucceeded()
-			adapter.release.Status.SnapshotEnvironmentBinding = snapshotEnvironmentBinding.Namespace + "/" + snapshotEnvironmentBinding.Name
-
-			result, err := adapter.EnsureSnapshotEnvironmentBindingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).NotTo(Succeed())
+			Expect(adapter.release.IsDone()).To(BeFalse())
+			Expect(adapter.release.Status.CompletionTime).To(BeNil())
+			Expect(adapter.release.Status.Results).To(BeEmpty())
 		})
 	})
 
-	Context("When createReleasePipelineRun is called", func() {
+	Context("When EnsureReleaseOutcomeIsNotified is called", func() {
 		var (
-			adapter     *Adapter
-			pipelineRun *v1beta1.PipelineRun
+			adapter *Adapter
+			cancel  context.CancelFunc
 		)
 
 		AfterEach(func() {
+			cancel()
 			_ = adapter.client.Delete(ctx, adapter.release)
-
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
 		})
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
 
-			var err error
-			pipelineRun, err = adapter.createReleasePipelineRun(releaseStrategy, enterpriseContractPolicy, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
-		})
+			releaseNotifier := notifier.NewNotifier()
+			releaseNotifier.SetAllowedHosts([]string{"127.0.0.1"})
+			adapter.dispatcher = notifier.NewDispatcher(releaseNotifier, ctrl.Log)
 
-		It("returns a PipelineRun", func() {
-			Expect(reflect.TypeOf(pipelineRun)).To(Equal(reflect.TypeOf(&v1beta1.PipelineRun{})))
-		})
+			var dispatcherCtx context.Context
+			dispatcherCtx, cancel = context.WithCancel(ctx)
+			go func() { _ = adapter.dispatcher.Start(dispatcherCtx) }()
 
-		It("has owner annotations", func() {
-			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
-			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
+			adapter.release.Status.StartTime = &metav1.Time{Time: time.Now()}
+			adapter.release.MarkRunning()
 		})
 
-		It("has release labels", func() {
-			Expect(pipelineRun.GetLabels()[tekton.PipelinesTypeLabel]).To(Equal("release"))
-			Expect(pipelineRun.GetLabels()[tekton.ReleaseNameLabel]).To(Equal(adapter.release.Name))
-			Expect(pipelineRun.GetLabels()[tekton.ReleaseNamespaceLabel]).To(Equal(testNamespace))
+		It("skips the operation if the release hasn't finished", func() {
+			result, err := adapter.EnsureReleaseOutcomeIsNotified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Notified).To(BeFalse())
 		})
 
-		It("references the pipeline specified in the ReleaseStrategy", func() {
-			Expect(pipelineRun.Spec.PipelineRef.Name).To(Equal(releaseStrategy.Spec.Pipeline))
-		})
+		It("notifies the webhook matching the Release outcome once the notified status is persisted", func() {
+			var successCalls, failureCalls int32
+			successServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&successCalls, 1)
+			}))
+			defer successServer.Close()
+			failureServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&failureCalls, 1)
+			}))
+			defer failureServer.Close()
+
+			newReleaseStrategy := releaseStrategy.DeepCopy()
+			newReleaseStrategy.Spec.Webhooks = &v1alpha1.Webhooks{
+				OnSuccess: &v1alpha1.Webhook{URL: successServer.URL},
+				OnFailure: &v1alpha1.Webhook{URL: failureServer.URL},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   newReleaseStrategy,
+				},
+			})
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
 
-		It("contains a parameter with the json representation of the EnterpriseContractPolicy", func() {
-			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
-		})
+			result, err := adapter.EnsureReleaseOutcomeIsNotified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
 
-		It("contains a parameter with the json representation of the Snapshot", func() {
-			jsonSpec, _ := json.Marshal(snapshot.Spec)
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+			persistedRelease := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(adapter.release), persistedRelease)).To(Succeed())
+			Expect(persistedRelease.Status.Notified).To(BeTrue())
+
+			Eventually(func() int32 { return atomic.LoadInt32(&failureCalls) }).Should(Equal(int32(1)))
+			Expect(atomic.LoadInt32(&successCalls)).To(Equal(int32(0)))
 		})
-	})
 
-	Context("When registerGitOpsDeploymentStatus is called", func() {
-		var adapter *Adapter
+		It("notifies the notification channels of the Release even if its ReleasePlanAdmission can't be found", func() {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+			}))
+			defer server.Close()
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
-		})
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("no ReleasePlanAdmission found"),
+				},
+			})
+			adapter.release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: server.URL},
+			}
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
+			result, err := adapter.EnsureReleaseOutcomeIsNotified()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
 		})
 
-		It("does nothing if there is no binding", func() {
-			Expect(adapter.registerGitOpsDeploymentStatus(nil)).To(Succeed())
-			Expect(adapter.release.IsDeploying()).To(BeFalse())
-		})
+		It("doesn't notify the release again once it has been notified", func() {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+			}))
+			defer server.Close()
 
-		It("does nothing if the binding doesn't have the expected condition", func() {
-			Expect(adapter.registerGitOpsDeploymentStatus(snapshotEnvironmentBinding)).To(Succeed())
-			Expect(adapter.release.IsDeploying()).To(BeFalse())
-		})
+			adapter.release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: server.URL},
+			}
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
 
-		It("registers the deployment status when the binding has been deployed", func() {
-			adapter.release.MarkDeploying(metav1.ConditionFalse, "", "")
-			newSnapshotEnvironmentBinding := snapshotEnvironmentBinding.DeepCopy()
-			newSnapshotEnvironmentBinding.Status.ComponentDeploymentConditions = []metav1.Condition{
-				{
-					Type:   applicationapiv1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
-					Status: metav1.ConditionTrue,
-					Reason: "Deployed",
-				},
+			for i := 0; i < 2; i++ {
+				result, err := adapter.EnsureReleaseOutcomeIsNotified()
+				Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
 			}
-			Expect(adapter.registerGitOpsDeploymentStatus(newSnapshotEnvironmentBinding)).To(Succeed())
-			Expect(adapter.release.IsDeployed()).To(BeTrue())
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+			Consistently(func() int32 { return atomic.LoadInt32(&calls) }, "200ms").Should(Equal(int32(1)))
 		})
-	})
 
-	Context("When registerReleasePipelineRunStatus is called", func() {
-		var adapter *Adapter
+		It("doesn't notify the ReleaseStrategy webhooks again once the release has been notified", func() {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+			}))
+			defer server.Close()
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
-		})
+			newReleaseStrategy := releaseStrategy.DeepCopy()
+			newReleaseStrategy.Spec.Webhooks = &v1alpha1.Webhooks{
+				OnSuccess: &v1alpha1.Webhook{URL: server.URL},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleaseStrategyContextKey,
+					Resource:   newReleaseStrategy,
+				},
+			})
+			adapter.release.MarkSucceeded()
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
+			for i := 0; i < 2; i++ {
+				result, err := adapter.EnsureReleaseOutcomeIsNotified()
+				Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+			Consistently(func() int32 { return atomic.LoadInt32(&calls) }, "200ms").Should(Equal(int32(1)))
 		})
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerReleasePipelineRunStatus(nil)).To(Succeed())
-			Expect(adapter.release.Status.CompletionTime).To(BeNil())
-		})
+		It("requeues the release without marking it as notified if the notification queue is full", func() {
+			for adapter.dispatcher.Reserve() {
+			}
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
 
-		It("does nothing if the PipelineRun is not done", func() {
-			pipelineRun := &v1beta1.PipelineRun{}
-			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.CompletionTime).To(BeNil())
+			result, err := adapter.EnsureReleaseOutcomeIsNotified()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(Equal(notificationQueueFullRequeueDelay))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.Notified).To(BeFalse())
 		})
 
-		It("sets the Release completion time", func() {
-			pipelineRun := &v1beta1.PipelineRun{}
-			pipelineRun.Status.MarkSucceeded("", "")
-			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.CompletionTime).NotTo(BeNil())
-		})
+		It("doesn't notify the release if the notified status can't be persisted", func() {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+			}))
+			defer server.Close()
 
-		It("sets the Release as succeeded if the PipelineRun succeeded", func() {
-			pipelineRun := &v1beta1.PipelineRun{}
-			pipelineRun.Status.MarkSucceeded("", "")
-			adapter.release.MarkRunning()
-			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasSucceeded()).To(BeTrue())
-		})
+			adapter.client = &conflictingClient{Client: k8sClient, conflicts: 100}
+			adapter.release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: server.URL},
+			}
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
 
-		It("sets the Release as failed if the PipelineRun didn't succeed", func() {
-			pipelineRun := &v1beta1.PipelineRun{}
-			pipelineRun.Status.MarkFailed("", "")
-			adapter.release.MarkRunning()
-			Expect(adapter.registerReleasePipelineRunStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasSucceeded()).To(BeFalse())
+			result, err := adapter.EnsureReleaseOutcomeIsNotified()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.Status.Notified).To(BeFalse())
+			Consistently(func() int32 { return atomic.LoadInt32(&calls) }, "200ms").Should(Equal(int32(0)))
 		})
 	})
 
@@ -803,6 +4853,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		It("does nothing if there is no PipelineRun", func() {
 			Expect(adapter.registerReleaseStatusData(nil, nil)).To(Succeed())
 			Expect(adapter.release.Status.ReleasePipelineRun).To(BeEmpty())
+			Expect(adapter.release.Status.PipelineRunRef).To(BeNil())
 		})
 
 		It("does nothing if there is no ReleaseStrategy", func() {
@@ -814,6 +4865,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			}
 			Expect(adapter.registerReleaseStatusData(pipelineRun, nil)).To(Succeed())
 			Expect(adapter.release.Status.ReleasePipelineRun).To(BeEmpty())
+			Expect(adapter.release.Status.PipelineRunRef).To(BeNil())
 		})
 
 		It("registers the Release data", func() {
@@ -821,15 +4873,129 @@ var _ = Describe("Release Adapter", Ordered, func() {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "pipeline-run",
 					Namespace: "default",
+					UID:       "pipeline-run-uid",
+					Labels:    map[string]string{tekton.ApplicationNameLabel: "application"},
+				},
+				Spec: v1beta1.PipelineRunSpec{
+					PipelineRef: &v1beta1.PipelineRef{Name: "release-pipeline"},
 				},
 			}
 			Expect(adapter.registerReleaseStatusData(pipelineRun, releaseStrategy)).To(Succeed())
+			Expect(adapter.release.Status.Application).To(Equal("application"))
+			Expect(adapter.release.Status.Pipeline).To(Equal("release-pipeline"))
 			Expect(adapter.release.Status.ReleasePipelineRun).To(Equal(fmt.Sprintf("%s%c%s",
 				pipelineRun.Namespace, types.Separator, pipelineRun.Name)))
+			Expect(adapter.release.Status.PipelineRunRef).NotTo(BeNil())
+			Expect(adapter.release.Status.PipelineRunRef.APIVersion).To(Equal(v1beta1.SchemeGroupVersion.String()))
+			Expect(adapter.release.Status.PipelineRunRef.Kind).To(Equal("PipelineRun"))
+			Expect(adapter.release.Status.PipelineRunRef.Name).To(Equal(pipelineRun.Name))
+			Expect(adapter.release.Status.PipelineRunRef.Namespace).To(Equal(pipelineRun.Namespace))
+			Expect(adapter.release.Status.PipelineRunRef.UID).To(Equal(pipelineRun.UID))
 			Expect(adapter.release.Status.ReleaseStrategy).To(Equal(fmt.Sprintf("%s%c%s",
 				releaseStrategy.Namespace, types.Separator, releaseStrategy.Name)))
 			Expect(adapter.release.Status.Target).To(Equal(pipelineRun.Namespace))
 		})
+
+		It("registers the source annotations and keeps them when the Release annotations change", func() {
+			os.Setenv("RELEASE_SOURCE_ANNOTATIONS", "ci/build-number")
+			defer os.Unsetenv("RELEASE_SOURCE_ANNOTATIONS")
+
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			adapter.release.Annotations = map[string]string{"ci/build-number": "42"}
+			Expect(adapter.registerReleaseStatusData(pipelineRun, releaseStrategy)).To(Succeed())
+			Expect(adapter.release.Status.SourceAnnotations).To(Equal(map[string]string{"ci/build-number": "42"}))
+
+			delete(adapter.release.Annotations, "ci/build-number")
+			Expect(adapter.registerReleaseStatusData(pipelineRun, releaseStrategy)).To(Succeed())
+			Expect(adapter.release.Status.SourceAnnotations).To(Equal(map[string]string{"ci/build-number": "42"}))
+		})
+
+		It("registers the params diff against the previous successful Release", func() {
+			pipelineRun := &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			newReleaseStrategy := releaseStrategy.DeepCopy()
+			newReleaseStrategy.Spec.Params = []v1alpha1.Params{{Name: "foo", Value: "new"}}
+			previousRelease := &v1alpha1.Release{
+				Status: v1alpha1.ReleaseStatus{
+					Params:          []v1alpha1.Params{{Name: "foo", Value: "old"}},
+					ReleaseStrategy: fmt.Sprintf("%s%c%s", releaseStrategy.Namespace, types.Separator, releaseStrategy.Name),
+				},
+			}
+			adapter.ctx = loader.GetMockedContext(ctx, []loader.MockData{
+				{
+					ContextKey: loader.PreviousSuccessfulReleaseContextKey,
+					Resource:   previousRelease,
+				},
+			})
+
+			Expect(adapter.registerReleaseStatusData(pipelineRun, newReleaseStrategy)).To(Succeed())
+			Expect(adapter.release.Status.Params).To(Equal(newReleaseStrategy.Spec.Params))
+			Expect(adapter.release.Status.ParamsDiff).To(Equal([]v1alpha1.ParamDiff{
+				{Name: "foo", Previous: "old", Current: "new"},
+			}))
+		})
+	})
+
+	Context("When registerReleaseSummaryAnnotation is called", func() {
+		var adapter *Adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if the Release is not done", func() {
+			adapter.release.MarkRunning()
+			Expect(adapter.registerReleaseSummaryAnnotation()).To(Succeed())
+			Expect(adapter.release.Annotations).NotTo(HaveKey(v1alpha1.SummaryAnnotation))
+		})
+
+		It("writes the summary once the Release is done", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			Expect(adapter.registerReleaseSummaryAnnotation()).To(Succeed())
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Annotations).To(HaveKey(v1alpha1.SummaryAnnotation))
+
+			var summary releaseSummary
+			Expect(json.Unmarshal([]byte(release.Annotations[v1alpha1.SummaryAnnotation]), &summary)).To(Succeed())
+			Expect(summary.Outcome).To(Equal(v1alpha1.OutcomeLabelFailed))
+			Expect(summary.Reason).To(Equal(v1alpha1.ReleaseReasonPipelineFailed.String()))
+		})
+
+		It("doesn't rewrite the summary on subsequent reconciles", func() {
+			adapter.release.MarkRunning()
+			adapter.release.MarkSucceeded()
+			Expect(adapter.registerReleaseSummaryAnnotation()).To(Succeed())
+			resourceVersion := adapter.release.ResourceVersion
+
+			Expect(adapter.registerReleaseSummaryAnnotation()).To(Succeed())
+			Expect(adapter.release.ResourceVersion).To(Equal(resourceVersion))
+
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.ResourceVersion).To(Equal(resourceVersion))
+		})
 	})
 
 	Context("When createOrUpdateSnapshotEnvironmentBinding is called", func() {
@@ -931,15 +5097,27 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 
 		It("finalizes the Release and deletes the PipelineRun", func() {
-			pipelineRun, err := adapter.createReleasePipelineRun(releaseStrategy, enterpriseContractPolicy, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(err).NotTo(HaveOccurred())
+			pipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
 
 			Expect(adapter.finalizeRelease()).To(Succeed())
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			pipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
 			Expect(pipelineRun).To(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("finalizes the Release without deleting an adopted PipelineRun", func() {
+			pipelineRun := adapter.newReleasePipelineRun(releasePlanAdmission, releaseStrategy, enterpriseContractPolicy, snapshot)
+			pipelineRun.Labels[tekton.ReleaseNameLabel] = "other-release"
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+			adapter.release.Status.ReleasePipelineRun = pipelineRun.Namespace + "/" + pipelineRun.Name
+
+			Expect(adapter.finalizeRelease()).To(Succeed())
+			adoptedPipelineRun, err := adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adoptedPipelineRun).NotTo(BeNil())
+			Expect(adapter.client.Delete(adapter.ctx, adoptedPipelineRun)).To(Succeed())
+		})
 	})
 
 	Context("When calling syncResources", func() {
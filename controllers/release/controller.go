@@ -18,6 +18,7 @@ package release
 
 import (
 	"context"
+
 	"github.com/go-logr/logr"
 	libhandler "github.com/operator-framework/operator-lib/handler"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
@@ -26,15 +27,22 @@ import (
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/cache"
 	"github.com/redhat-appstudio/release-service/gitops"
+	"github.com/redhat-appstudio/release-service/heartbeat"
 	"github.com/redhat-appstudio/release-service/loader"
+	"github.com/redhat-appstudio/release-service/notifier"
+	"github.com/redhat-appstudio/release-service/oci"
 	"github.com/redhat-appstudio/release-service/tekton"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
@@ -42,16 +50,29 @@ import (
 // Reconciler reconciles a Release object
 type Reconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log       logr.Logger
+	Scheme    *runtime.Scheme
+	heartbeat *heartbeat.Heartbeat
+	logSource tekton.LogSource
+	recorder  record.EventRecorder
+
+	// dispatcher is shared by all the reconciles so the notifications are sent in the background
+	dispatcher *notifier.Dispatcher
+
+	// strategyResolver is shared by all the reconciles so the ReleaseStrategy artifacts are only fetched once
+	strategyResolver *oci.ReleaseStrategyResolver
+
+	// watchLabelSelector is the selector the Releases reconciled by this controller have to match
+	watchLabelSelector labels.Selector
 }
 
 // NewReleaseReconciler creates and returns a Reconciler.
 func NewReleaseReconciler(client client.Client, logger *logr.Logger, scheme *runtime.Scheme) *Reconciler {
 	return &Reconciler{
-		Client: client,
-		Log:    logger.WithName("release"),
-		Scheme: scheme,
+		Client:    client,
+		Log:       logger.WithName("release"),
+		Scheme:    scheme,
+		heartbeat: newHeartbeat(client),
 	}
 }
 
@@ -61,14 +82,22 @@ func NewReleaseReconciler(client client.Client, logger *logr.Logger, scheme *run
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=applications/finalizers,verbs=update
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies/status,verbs=get
+//+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;create;update
+//+kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	logger := r.Log.WithValues("Release", req.NamespacedName)
 
 	release := &v1alpha1.Release{}
-	err := r.Get(ctx, req.NamespacedName, release)
+	err = r.Get(ctx, req.NamespacedName, release)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return ctrl.Result{}, nil
@@ -77,23 +106,89 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// PipelineRuns and bindings can enqueue Releases handled by other controller instances, so filter them here too
+	if r.watchLabelSelector != nil && !r.watchLabelSelector.Matches(labels.Set(release.Labels)) {
+		return ctrl.Result{}, nil
+	}
+
 	adapter := NewAdapter(ctx, r.Client, release, loader.NewLoader(), logger)
+	adapter.dispatcher = r.dispatcher
+	adapter.logSource = r.logSource
+	adapter.recorder = r.recorder
+	adapter.strategyResolver = r.strategyResolver
+
+	// The operations only modify the Release status in memory, so write all the changes at once whatever the outcome
+	defer func() {
+		if labelErr := adapter.registerReleaseOutcomeLabel(); labelErr != nil {
+			logger.Error(labelErr, "Unable to update the Release outcome label")
+			if err == nil {
+				result, err = ctrl.Result{}, labelErr
+			}
+		}
+
+		if summaryErr := adapter.registerReleaseSummaryAnnotation(); summaryErr != nil {
+			logger.Error(summaryErr, "Unable to update the Release summary annotation")
+			if err == nil {
+				result, err = ctrl.Result{}, summaryErr
+			}
+		}
+
+		if flushErr := adapter.FlushStatus(); flushErr != nil {
+			logger.Error(flushErr, "Unable to update the Release status")
+			if err == nil {
+				result, err = ctrl.Result{}, flushErr
+			}
+		}
+	}()
 
-	return reconciler.ReconcileHandler([]reconciler.ReconcileOperation{
+	result, err = reconciler.ReconcileHandler([]reconciler.ReconcileOperation{
+		adapter.EnsureReleaseIsNotPaused,
+		adapter.EnsureTargetOverrideIsAllowed,
+		adapter.EnsureReleaseStrategyIsAllowed,
 		adapter.EnsureReleasePlanAdmissionEnabled,
 		adapter.EnsureFinalizersAreCalled,
 		adapter.EnsureFinalizerIsAdded,
+		adapter.EnsureReleaseIsValidated,
+		adapter.EnsureReleaseDependenciesAreMet,
+		adapter.EnsureReleaseIsApproved,
 		adapter.EnsureReleasePipelineRunExists,
 		adapter.EnsureReleasePipelineStatusIsTracked,
+		adapter.EnsureReleaseOutcomeIsNotified,
+		adapter.EnsureReleaseProvenanceIsVerified,
 		adapter.EnsureSnapshotEnvironmentBindingExists,
 		adapter.EnsureSnapshotEnvironmentBindingIsTracked,
+		adapter.EnsureCollectorsPipelineRunExists,
+		adapter.EnsureCollectorsPipelineRunIsTracked,
+		adapter.EnsureExpiredReleaseIsDeleted,
 	})
 
+	if heartbeatErr := r.heartbeat.Beat(ctx, release); heartbeatErr != nil {
+		logger.Error(heartbeatErr, "Unable to update the heartbeat Lease")
+	}
+
+	return result, err
 }
 
 // SetupController creates a new Release reconciler and adds it to the Manager.
 func SetupController(manager ctrl.Manager, log *logr.Logger) error {
-	return setupControllerWithManager(manager, NewReleaseReconciler(manager.GetClient(), log, manager.GetScheme()))
+	reconciler := NewReleaseReconciler(manager.GetClient(), log, manager.GetScheme())
+
+	logSource, err := tekton.NewPodLogSource(manager.GetConfig())
+	if err != nil {
+		return err
+	}
+	reconciler.logSource = logSource
+	reconciler.recorder = manager.GetEventRecorderFor("release-controller")
+	reconciler.strategyResolver = oci.NewReleaseStrategyResolver(oci.NewRegistryResolver())
+
+	releaseNotifier := notifier.NewNotifier()
+	releaseNotifier.SetAllowedHosts(getNotificationAllowedHosts())
+	reconciler.dispatcher = notifier.NewDispatcher(releaseNotifier, reconciler.Log.WithName("notifier"))
+	if err := manager.Add(reconciler.dispatcher); err != nil {
+		return err
+	}
+
+	return setupControllerWithManager(manager, reconciler)
 }
 
 // setupCache indexes fields for each of the resources used in the release adapter in those cases where filtering by
@@ -110,17 +205,22 @@ func setupCache(mgr ctrl.Manager) error {
 	return cache.SetupSnapshotEnvironmentBindingCache(mgr)
 }
 
-// setupControllerWithManager sets up the controller with the Manager which monitors new Releases and filters out
-// status updates. This controller also watches for PipelineRuns and SnapshotEnvironmentBindings that are created
-// by this controller and owned by the Releases so the owner gets reconciled on changes.
+// setupControllerWithManager sets up the controller with the Manager which monitors Releases and their PipelineRuns.
 func setupControllerWithManager(manager ctrl.Manager, reconciler *Reconciler) error {
 	err := setupCache(manager)
 	if err != nil {
 		return err
 	}
 
+	reconciler.watchLabelSelector, err = getWatchLabelSelector()
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(manager).
-		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&v1alpha1.Release{}, builder.WithPredicates(
+			reconciledReleasePredicate(),
+			watchedReleasePredicate(reconciler.watchLabelSelector))).
 		Watches(&source.Kind{Type: &applicationapiv1alpha1.SnapshotEnvironmentBinding{}}, &libhandler.EnqueueRequestForAnnotation{
 			Type: schema.GroupKind{
 				Kind:  "Release",
@@ -135,3 +235,105 @@ func setupControllerWithManager(manager ctrl.Manager, reconciler *Reconciler) er
 		}, builder.WithPredicates(tekton.ReleasePipelineRunSucceededPredicate())).
 		Complete(reconciler)
 }
+
+// reconciledReleasePredicate returns a predicate which lets through the Release events that require a reconcile:
+// generation changes, changes to the pause, approved-by and dry-run annotations and deletions of Releases carrying the
+// release finalizer.
+func reconciledReleasePredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		pauseAnnotationChangedPredicate(),
+		approvedByAnnotationChangedPredicate(),
+		dryRunAnnotationChangedPredicate(),
+		releaseDeletionPredicate(),
+	)
+}
+
+// releaseDeletionPredicate returns a predicate which only lets through the deletion events of Releases carrying the
+// release finalizer, including the update setting their deletion timestamp, so the finalizer is always called even if
+// the deletion doesn't change the Release generation.
+func releaseDeletionPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return controllerutil.ContainsFinalizer(deleteEvent.Object, finalizerName)
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetDeletionTimestamp() == nil &&
+				updateEvent.ObjectNew.GetDeletionTimestamp() != nil &&
+				controllerutil.ContainsFinalizer(updateEvent.ObjectNew, finalizerName)
+		},
+	}
+}
+
+// pauseAnnotationChangedPredicate returns a predicate which only lets through the Release updates changing the pause
+// annotation, so Releases are reconciled as soon as they are paused or resumed.
+func pauseAnnotationChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetAnnotations()[v1alpha1.PauseAnnotation] !=
+				updateEvent.ObjectNew.GetAnnotations()[v1alpha1.PauseAnnotation]
+		},
+	}
+}
+
+// approvedByAnnotationChangedPredicate returns a predicate which only lets through the Release updates changing the
+// approved-by annotation, so Releases waiting for an approval are reconciled as soon as they are approved.
+func approvedByAnnotationChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetAnnotations()[v1alpha1.ApprovedByAnnotation] !=
+				updateEvent.ObjectNew.GetAnnotations()[v1alpha1.ApprovedByAnnotation]
+		},
+	}
+}
+
+// dryRunAnnotationChangedPredicate returns a predicate which only lets through the Release updates changing the dry-run
+// annotation, so the release PipelineRun of a Release leaving the dry-run mode is created straight away.
+func dryRunAnnotationChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetAnnotations()[v1alpha1.DryRunAnnotation] !=
+				updateEvent.ObjectNew.GetAnnotations()[v1alpha1.DryRunAnnotation]
+		},
+	}
+}
+
+// watchedReleasePredicate returns a predicate which filters out all the Releases not matching the given selector.
+func watchedReleasePredicate(selector labels.Selector) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return selector.Matches(labels.Set(object.GetLabels()))
+	})
+}
@@ -17,14 +17,22 @@ limitations under the License.
 package release
 
 import (
+	"fmt"
+	"os"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -52,6 +60,38 @@ var _ = Describe("Release Controller", Ordered, func() {
 			Expect(reflect.TypeOf(result)).To(Equal(reflect.TypeOf(reconcile.Result{})))
 			Expect(err).To(BeNil())
 		})
+
+		It("should skip the release if it doesn't match the watch label selector", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "unwatched-release-",
+					Namespace:    "default",
+					Labels:       map[string]string{"team": "other"},
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: "release-plan",
+				},
+			}
+			Expect(k8sClient.Create(ctx, release)).To(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, release) }()
+
+			reconciler := NewReleaseReconciler(k8sClient, &ctrl.Log, scheme.Scheme)
+			reconciler.watchLabelSelector = labels.SelectorFromSet(labels.Set{"team": "payments"})
+			req := ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      release.Name,
+					Namespace: release.Namespace,
+				},
+			}
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).To(BeNil())
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, release)).To(Succeed())
+			Expect(release.Finalizers).To(BeEmpty())
+			Expect(release.Status.Conditions).To(BeEmpty())
+		})
 	})
 
 	Context("When SetupController is called", func() {
@@ -86,6 +126,157 @@ var _ = Describe("Release Controller", Ordered, func() {
 			})
 			Expect(setupControllerWithManager(manager, reconciler)).To(Succeed())
 		})
+
+		It("should fail to setup the controller if the watch label selector is not valid", func() {
+			os.Setenv("WATCH_LABEL_SELECTOR", "team in payments")
+			defer os.Unsetenv("WATCH_LABEL_SELECTOR")
+
+			reconciler := NewReleaseReconciler(k8sClient, &ctrl.Log, scheme.Scheme)
+			manager, _ := ctrl.NewManager(cfg, ctrl.Options{
+				Scheme:             scheme.Scheme,
+				MetricsBindAddress: "0", // disable metrics
+				LeaderElection:     false,
+			})
+			Expect(setupControllerWithManager(manager, reconciler)).NotTo(Succeed())
+		})
+
+		It("should only reconcile the Releases matching the watch label selector", func() {
+			instance := watchedReleasePredicate(labels.SelectorFromSet(labels.Set{"team": "payments"}))
+			matchingRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "matching-release",
+					Namespace: "default",
+					Labels:    map[string]string{"team": "payments"},
+				},
+			}
+			otherRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-release",
+					Namespace: "default",
+				},
+			}
+
+			Expect(instance.Create(event.CreateEvent{Object: matchingRelease})).To(BeTrue())
+			Expect(instance.Create(event.CreateEvent{Object: otherRelease})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: otherRelease, ObjectNew: otherRelease})).To(BeFalse())
+		})
+
+		It("should reconcile every Release if the watch label selector is empty", func() {
+			instance := watchedReleasePredicate(labels.Everything())
+			Expect(instance.Create(event.CreateEvent{Object: &v1alpha1.Release{}})).To(BeTrue())
+		})
+
+		It("should trigger reconciles when the pause annotation of a Release changes", func() {
+			instance := pauseAnnotationChangedPredicate()
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+			}
+			pausedRelease := release.DeepCopy()
+			pausedRelease.SetAnnotations(map[string]string{v1alpha1.PauseAnnotation: "true"})
+
+			Expect(instance.Create(event.CreateEvent{Object: release})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: pausedRelease})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: pausedRelease, ObjectNew: release})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: pausedRelease, ObjectNew: pausedRelease})).To(BeFalse())
+		})
+
+		It("should trigger reconciles when the approved-by annotation of a Release changes", func() {
+			instance := reconciledReleasePredicate()
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+			}
+			approvedRelease := release.DeepCopy()
+			approvedRelease.SetAnnotations(map[string]string{v1alpha1.ApprovedByAnnotation: "alice"})
+
+			Expect(approvedByAnnotationChangedPredicate().Create(event.CreateEvent{Object: release})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: approvedRelease})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: approvedRelease, ObjectNew: approvedRelease})).To(BeFalse())
+		})
+
+		It("should trigger reconciles when the dry-run annotation of a Release changes", func() {
+			instance := reconciledReleasePredicate()
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+			}
+			dryRunRelease := release.DeepCopy()
+			dryRunRelease.SetAnnotations(map[string]string{v1alpha1.DryRunAnnotation: "true"})
+
+			Expect(dryRunAnnotationChangedPredicate().Create(event.CreateEvent{Object: release})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: dryRunRelease, ObjectNew: release})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: dryRunRelease, ObjectNew: dryRunRelease})).To(BeFalse())
+		})
+
+		It("should reconcile the deletion of a Release carrying the finalizer but not its status updates", func() {
+			instance := reconciledReleasePredicate()
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "release",
+					Namespace:  "default",
+					Generation: 1,
+					Finalizers: []string{finalizerName},
+				},
+			}
+			deletedRelease := release.DeepCopy()
+			deletedRelease.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			updatedRelease := release.DeepCopy()
+			updatedRelease.MarkRunning()
+
+			Expect(instance.Delete(event.DeleteEvent{Object: release})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: deletedRelease})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: updatedRelease})).To(BeFalse())
+		})
+
+		It("should only let through the deletions of Releases carrying the finalizer", func() {
+			instance := releaseDeletionPredicate()
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "release",
+					Namespace:  "default",
+					Finalizers: []string{finalizerName},
+				},
+			}
+			deletedRelease := release.DeepCopy()
+			deletedRelease.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			unfinalizedRelease := deletedRelease.DeepCopy()
+			unfinalizedRelease.Finalizers = nil
+
+			Expect(instance.Create(event.CreateEvent{Object: release})).To(BeFalse())
+			Expect(instance.Delete(event.DeleteEvent{Object: release})).To(BeTrue())
+			Expect(instance.Delete(event.DeleteEvent{Object: unfinalizedRelease})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: deletedRelease})).To(BeTrue())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: deletedRelease, ObjectNew: deletedRelease})).To(BeFalse())
+			Expect(instance.Update(event.UpdateEvent{ObjectOld: release, ObjectNew: release})).To(BeFalse())
+		})
+
+		It("should not retrigger reconciles when only the Release annotations change", func() {
+			instance := predicate.GenerationChangedPredicate{}
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "release",
+					Namespace:  "default",
+					Generation: 1,
+				},
+			}
+
+			for i := 0; i < 100; i++ {
+				updatedRelease := release.DeepCopy()
+				updatedRelease.SetAnnotations(map[string]string{"retrigger": fmt.Sprint(i)})
+				Expect(instance.Update(event.UpdateEvent{
+					ObjectOld: release,
+					ObjectNew: updatedRelease,
+				})).To(BeFalse())
+				release = updatedRelease
+			}
+		})
 	})
 
 })
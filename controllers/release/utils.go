@@ -0,0 +1,773 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"github.com/redhat-appstudio/release-service/heartbeat"
+	"github.com/redhat-appstudio/release-service/tekton"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// concurrencySlotRequeueDelay is the time to wait before checking again whether a Release waiting for a concurrency
+// slot can create its release PipelineRun.
+const concurrencySlotRequeueDelay = 30 * time.Second
+
+// insufficientQuotaRequeueDelay is the time to wait before checking again whether the target namespace has enough
+// quota left to run the release PipelineRun.
+const insufficientQuotaRequeueDelay = 30 * time.Second
+
+// workspaceStoragePollInterval is the time to wait before checking again whether the PersistentVolumeClaims backing
+// the release PipelineRun workspaces are bound.
+const workspaceStoragePollInterval = 10 * time.Second
+
+// notificationQueueFullRequeueDelay is the time to wait before trying again to notify the outcome of a Release when
+// the notification queue is full.
+const notificationQueueFullRequeueDelay = 10 * time.Second
+
+// releasePipelineRunPrefix is the prefix of the names generated for the release PipelineRuns that can't be named after
+// their Release.
+const releasePipelineRunPrefix = "release-pipelinerun"
+
+// imageDigestResult is the name of the release PipelineRun result holding the digest of the released image, following
+// the Tekton Chains type hinting convention.
+const imageDigestResult = "IMAGE_DIGEST"
+
+// defaultHeartbeatInterval is the default minimum time in seconds between two updates of the heartbeat Lease.
+const defaultHeartbeatInterval = 30
+
+// heartbeatLeaseName is the name of the Lease used as the controller heartbeat.
+const heartbeatLeaseName = "release-service-heartbeat"
+
+// dependencyRequeueDelay is the time to wait before checking again whether the Releases a Release depends on have
+// succeeded.
+const dependencyRequeueDelay = 30 * time.Second
+
+// defaultPipelineRunStatusPollInterval is the default time in seconds to wait before checking again the status of a
+// release PipelineRun that has no status conditions yet.
+const defaultPipelineRunStatusPollInterval = 5
+
+// defaultPipelineRunCreationBackoff is the default time in seconds to wait before trying again to create a release
+// PipelineRun when the API server is throttling requests.
+const defaultPipelineRunCreationBackoff = 10
+
+// defaultPipelineRunTimeout is the default timeout in seconds set on release PipelineRuns that would otherwise run
+// without a time limit.
+const defaultPipelineRunTimeout = 3600
+
+// defaultMissingPipelineRunCheckInterval is the default time in seconds to wait before checking again whether the
+// release PipelineRun of a running Release still exists.
+const defaultMissingPipelineRunCheckInterval = 300
+
+// defaultMaxParamsSize is the default maximum size in bytes of the params passed to a release PipelineRun. It is kept
+// well below the etcd object size limit so the PipelineRun can still be stored.
+const defaultMaxParamsSize = 1024 * 1024
+
+// defaultFailureLogLines is the default number of lines of the failed step logs stored in the Release status.
+const defaultFailureLogLines = 20
+
+// maxFailureLogSize is the maximum size in bytes of the failed step logs excerpt stored in the Release status.
+const maxFailureLogSize = 2048
+
+// pipelineRunFieldManager is the field manager used when applying the release PipelineRuns.
+const pipelineRunFieldManager = "release-service"
+
+// defaultReleasePlanAdmissionWaitTimeout is the default time in seconds a new Release waits for its ReleasePlan and
+// ReleasePlanAdmission to be created before being marked as invalid.
+const defaultReleasePlanAdmissionWaitTimeout = 300
+
+// releasePlanAdmissionMinRequeueDelay is the minimum time to wait before looking again for a missing ReleasePlan or
+// ReleasePlanAdmission.
+const releasePlanAdmissionMinRequeueDelay = 5 * time.Second
+
+// maxReasonHistoryLength is the maximum number of release PipelineRun reason transitions stored in the Release status.
+const maxReasonHistoryLength = 10
+
+// getEnvAsInt returns the value of the environment variable with the given name as an int. If the variable is not set
+// or its value is not a valid integer, the default value is returned.
+func getEnvAsInt(name string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getReleasePlanAdmissionWaitTimeout returns the time a new Release waits for its ReleasePlan and ReleasePlanAdmission
+// to be created before being marked as invalid. The value in seconds is read from the
+// RELEASE_PLAN_ADMISSION_WAIT_TIMEOUT environment variable, using defaultReleasePlanAdmissionWaitTimeout if it's not
+// set. A value of zero or lower means Releases are marked as invalid straight away.
+func getReleasePlanAdmissionWaitTimeout() time.Duration {
+	return time.Duration(getEnvAsInt("RELEASE_PLAN_ADMISSION_WAIT_TIMEOUT", defaultReleasePlanAdmissionWaitTimeout)) *
+		time.Second
+}
+
+// getMissingReleasePlanAdmissionRequeueDelay returns the time to wait before looking again for the missing ReleasePlan
+// or ReleasePlanAdmission of a Release created the given time ago. Waiting as long as the Release has already waited
+// doubles the delay on every attempt without having to store the number of attempts. The delay never goes past the
+// given timeout, so the last attempt is done when the Release stops waiting.
+func getMissingReleasePlanAdmissionRequeueDelay(elapsed, timeout time.Duration) time.Duration {
+	delay := elapsed
+	if delay < releasePlanAdmissionMinRequeueDelay {
+		delay = releasePlanAdmissionMinRequeueDelay
+	}
+
+	if remaining := timeout - elapsed; delay > remaining {
+		delay = remaining
+	}
+
+	return delay
+}
+
+// isMissingReleasePlanAdmissionError returns true if the given error was returned because the ReleasePlan referenced by
+// a Release or its matching ReleasePlanAdmission doesn't exist.
+func isMissingReleasePlanAdmissionError(err error) bool {
+	return errors.IsNotFound(err) || strings.Contains(err.Error(), "no ReleasePlanAdmission found")
+}
+
+// getReleasePlanAdmissionErrorReason returns the reason to mark a Release as invalid with when its ReleasePlanAdmission
+// can't be found because of the given error. Missing ReleasePlans and ReleasePlanAdmissions get their own reasons, so
+// they can be told apart from other validation errors.
+func getReleasePlanAdmissionErrorReason(err error) v1alpha1.ReleaseReason {
+	if errors.IsNotFound(err) {
+		return v1alpha1.ReleaseReasonReleasePlanNotFound
+	}
+
+	if strings.Contains(err.Error(), "no ReleasePlanAdmission found") {
+		return v1alpha1.ReleaseReasonReleasePlanAdmissionNotFound
+	}
+
+	return v1alpha1.ReleaseReasonReleasePlanValidationError
+}
+
+// getReleaseStrategyErrorReason returns the reason to mark the given Release as invalid with when its ReleaseStrategy
+// can't be loaded because of the given error. Errors fetching an artifact and missing ReleaseStrategies get their own
+// reasons, so they can be told apart from other validation errors.
+func getReleaseStrategyErrorReason(release *v1alpha1.Release, releasePlanAdmission *v1alpha1.ReleasePlanAdmission,
+	err error) v1alpha1.ReleaseReason {
+	if usesReleaseStrategyArtifact(release, releasePlanAdmission) {
+		return v1alpha1.ReleaseReasonReleaseStrategyArtifactError
+	}
+
+	if errors.IsNotFound(err) {
+		return v1alpha1.ReleaseReasonReleaseStrategyNotFound
+	}
+
+	return v1alpha1.ReleaseReasonValidationError
+}
+
+// getFailureLogLines returns the number of lines of the failed step logs to store in the Release status when the
+// release PipelineRun fails. The value is read from the RELEASE_FAILURE_LOG_LINES environment variable, using
+// defaultFailureLogLines if it's not set. A value of zero or lower disables the capture of the logs.
+func getFailureLogLines() int {
+	return getEnvAsInt("RELEASE_FAILURE_LOG_LINES", defaultFailureLogLines)
+}
+
+// getMaxParamsSize returns the maximum size in bytes allowed for the params of a release PipelineRun. The value is read
+// from the RELEASE_PIPELINE_MAX_PARAMS_SIZE environment variable, using defaultMaxParamsSize if it's not set.
+func getMaxParamsSize() int {
+	return getEnvAsInt("RELEASE_PIPELINE_MAX_PARAMS_SIZE", defaultMaxParamsSize)
+}
+
+// getPipelineRunStatusPollInterval returns the time to wait before checking again the status of a release PipelineRun
+// whose status is not observable yet. The value in seconds is read from the RELEASE_PIPELINE_STATUS_POLL_INTERVAL
+// environment variable, using defaultPipelineRunStatusPollInterval if it's not set.
+func getPipelineRunStatusPollInterval() time.Duration {
+	return time.Duration(getEnvAsInt("RELEASE_PIPELINE_STATUS_POLL_INTERVAL", defaultPipelineRunStatusPollInterval)) *
+		time.Second
+}
+
+// getMissingPipelineRunCheckInterval returns the time to wait before checking again whether the release PipelineRun
+// of a running Release still exists, so a Release doesn't stay stuck if the deletion of its PipelineRun was missed.
+// The value in seconds is read from the RELEASE_PIPELINE_MISSING_CHECK_INTERVAL environment variable, using
+// defaultMissingPipelineRunCheckInterval if it's not set.
+func getMissingPipelineRunCheckInterval() time.Duration {
+	return time.Duration(getEnvAsInt("RELEASE_PIPELINE_MISSING_CHECK_INTERVAL", defaultMissingPipelineRunCheckInterval)) *
+		time.Second
+}
+
+// getFailedPipelineRunRetention returns the time a failed release PipelineRun is kept after its completion before the
+// Release that created it can be finalized, so the PipelineRun and its pods can be inspected. The value in seconds is
+// read from the RELEASE_PIPELINE_FAILED_RETENTION environment variable. A value of zero or lower means failed
+// PipelineRuns are deleted straight away.
+func getFailedPipelineRunRetention() time.Duration {
+	return time.Duration(getEnvAsInt("RELEASE_PIPELINE_FAILED_RETENTION", 0)) * time.Second
+}
+
+// getDefaultPipelineRunTimeout returns the timeout set on release PipelineRuns that would otherwise run without a time
+// limit. The value in seconds is read from the RELEASE_PIPELINE_DEFAULT_TIMEOUT environment variable, using
+// defaultPipelineRunTimeout if it's not set. A value of zero or lower means no default timeout is set.
+func getDefaultPipelineRunTimeout() time.Duration {
+	return time.Duration(getEnvAsInt("RELEASE_PIPELINE_DEFAULT_TIMEOUT", defaultPipelineRunTimeout)) * time.Second
+}
+
+// getPipelineRunCreationBackoff returns the time to wait before trying again to create a release PipelineRun after the
+// API server throttled the request with the given error. The value in seconds is read from the
+// RELEASE_PIPELINE_CREATION_BACKOFF environment variable, using defaultPipelineRunCreationBackoff if it's not set. If
+// the API server suggested a longer delay through a Retry-After hint, that delay is used instead.
+func getPipelineRunCreationBackoff(err error) time.Duration {
+	backoff := time.Duration(getEnvAsInt("RELEASE_PIPELINE_CREATION_BACKOFF", defaultPipelineRunCreationBackoff)) *
+		time.Second
+
+	if seconds, ok := errors.SuggestsClientDelay(err); ok {
+		if retryAfter := time.Duration(seconds) * time.Second; retryAfter > backoff {
+			return retryAfter
+		}
+	}
+
+	return backoff
+}
+
+// newHeartbeat returns a new Heartbeat maintaining its Lease in the namespace set in the HEARTBEAT_LEASE_NAMESPACE
+// environment variable. The Lease is updated at most once per the number of seconds set in the
+// HEARTBEAT_INTERVAL_SECONDS environment variable. If no namespace is set, nil is returned, disabling the heartbeat.
+func newHeartbeat(client client.Client) *heartbeat.Heartbeat {
+	namespace := os.Getenv("HEARTBEAT_LEASE_NAMESPACE")
+	if namespace == "" {
+		return nil
+	}
+
+	interval := getEnvAsInt("HEARTBEAT_INTERVAL_SECONDS", defaultHeartbeatInterval)
+
+	return heartbeat.NewHeartbeat(client, heartbeatLeaseName, namespace, time.Duration(interval)*time.Second)
+}
+
+// getReleaseStrategyReference returns the namespace and name of the ReleaseStrategy referenced in the spec of the
+// given Release. If the reference doesn't include a namespace, an empty namespace is returned.
+func getReleaseStrategyReference(release *v1alpha1.Release) (string, string) {
+	namespace, name, found := strings.Cut(release.Spec.ReleaseStrategy, string(types.Separator))
+	if !found {
+		return "", release.Spec.ReleaseStrategy
+	}
+
+	return namespace, name
+}
+
+// usesReleaseStrategyArtifact returns whether the ReleaseStrategy of the given Release has to be read from the OCI
+// artifact referenced by the given ReleasePlanAdmission. ReleaseStrategies set in the Release spec take precedence.
+func usesReleaseStrategyArtifact(release *v1alpha1.Release, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) bool {
+	return release.Spec.ReleaseStrategy == "" && releasePlanAdmission != nil &&
+		releasePlanAdmission.Spec.ReleaseStrategyArtifact != ""
+}
+
+// isReleaseStrategyNamespaceAllowed returns whether Releases can use ReleaseStrategies from the given namespace. Only
+// the shared namespaces listed in the comma-separated RELEASE_STRATEGY_SHARED_NAMESPACES environment variable are
+// allowed, as the Release namespace is controlled by the tenant.
+func isReleaseStrategyNamespaceAllowed(namespace string) bool {
+	if namespace == "" {
+		return false
+	}
+
+	for _, sharedNamespace := range strings.Split(os.Getenv("RELEASE_STRATEGY_SHARED_NAMESPACES"), ",") {
+		if strings.TrimSpace(sharedNamespace) == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBundleRegistryAllowed returns whether the given Tekton bundle is hosted in one of the registries listed in the
+// comma-separated ALLOWED_BUNDLE_REGISTRIES environment variable. All registries are allowed if the variable is not
+// set. Bundles that can't be parsed are never allowed when the list is set.
+func isBundleRegistryAllowed(bundle string) bool {
+	allowedRegistries := os.Getenv("ALLOWED_BUNDLE_REGISTRIES")
+	if bundle == "" || strings.TrimSpace(allowedRegistries) == "" {
+		return true
+	}
+
+	reference, err := name.ParseReference(bundle)
+	if err != nil {
+		return false
+	}
+
+	for _, allowedRegistry := range strings.Split(allowedRegistries, ",") {
+		registry, err := name.NewRegistry(strings.TrimSpace(allowedRegistry))
+		if err == nil && registry.RegistryStr() == reference.Context().RegistryStr() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getDisallowedBundle returns the first Tekton bundle used by the given ReleaseStrategy or collectors that is hosted in
+// a registry that is not allowed, or an empty string if all of them are allowed.
+func getDisallowedBundle(releaseStrategy *v1alpha1.ReleaseStrategy, collectors []v1alpha1.Collector) string {
+	if !isBundleRegistryAllowed(releaseStrategy.Spec.Bundle) {
+		return releaseStrategy.Spec.Bundle
+	}
+
+	return getDisallowedCollectorBundle(collectors)
+}
+
+// getDisallowedCollectorBundle returns the first Tekton bundle used by the given collectors that is hosted in a
+// registry that is not allowed, or an empty string if all of them are allowed.
+func getDisallowedCollectorBundle(collectors []v1alpha1.Collector) string {
+	for _, collector := range collectors {
+		if !isBundleRegistryAllowed(collector.Bundle) {
+			return collector.Bundle
+		}
+	}
+
+	return ""
+}
+
+// addReasonTransition returns the given reason history with the given transition appended, unless the reason is the
+// same as the one of the last transition. Only the most recent transitions are kept, up to maxReasonHistoryLength.
+func addReasonTransition(history []v1alpha1.ReasonTransition, transition v1alpha1.ReasonTransition) []v1alpha1.ReasonTransition {
+	if len(history) > 0 && history[len(history)-1].Reason == transition.Reason {
+		return history
+	}
+
+	history = append(history, transition)
+	if len(history) > maxReasonHistoryLength {
+		history = history[len(history)-maxReasonHistoryLength:]
+	}
+
+	return history
+}
+
+// getPipelineRunResults returns the results of the given PipelineRun, indexed by their names. Results that are not
+// strings are stored in json format.
+func getPipelineRunResults(pipelineRun *v1beta1.PipelineRun) map[string]string {
+	results := make(map[string]string, len(pipelineRun.Status.PipelineResults))
+
+	for _, result := range pipelineRun.Status.PipelineResults {
+		results[result.Name] = getResultValue(result.Value)
+	}
+
+	return results
+}
+
+// getReleaseResults returns the results of the given PipelineRun in the order they were reported. Results that are not
+// strings are stored in json format.
+func getReleaseResults(pipelineRun *v1beta1.PipelineRun) []v1alpha1.ReleaseResult {
+	var results []v1alpha1.ReleaseResult
+
+	for _, result := range pipelineRun.Status.PipelineResults {
+		results = append(results, v1alpha1.ReleaseResult{
+			Name:  result.Name,
+			Value: getResultValue(result.Value),
+		})
+	}
+
+	return results
+}
+
+// getResultValue returns the string representation of the given result value. Values that are not strings are
+// represented using their json encoding.
+func getResultValue(value v1beta1.ResultValue) string {
+	if value.Type == v1beta1.ParamTypeString || value.Type == "" {
+		return value.StringVal
+	}
+
+	encodedValue, _ := json.Marshal(value)
+	return string(encodedValue)
+}
+
+// getCollectorResults returns the results produced by the given collectors TaskRuns. Each TaskRun is matched to its
+// collector through the name of the pipeline task it ran. Results that are not strings are stored in json format.
+func getCollectorResults(taskRuns []v1beta1.TaskRun) []v1alpha1.CollectorResult {
+	var collectorResults []v1alpha1.CollectorResult
+
+	for _, taskRun := range taskRuns {
+		collectorResult := v1alpha1.CollectorResult{
+			Name: taskRun.Labels["tekton.dev/pipelineTask"],
+		}
+
+		for _, result := range taskRun.Status.TaskRunResults {
+			if collectorResult.Results == nil {
+				collectorResult.Results = make(map[string]string)
+			}
+
+			collectorResult.Results[result.Name] = getResultValue(result.Value)
+		}
+
+		collectorResults = append(collectorResults, collectorResult)
+	}
+
+	sort.Slice(collectorResults, func(i, j int) bool {
+		return collectorResults[i].Name < collectorResults[j].Name
+	})
+
+	return collectorResults
+}
+
+// releaseSummary holds the final outcome of a Release as written in its summary annotation. It only contains data that
+// doesn't change once the Release is done, so the annotation remains stable across reconciles.
+type releaseSummary struct {
+	Outcome            string `json:"outcome"`
+	Reason             string `json:"reason,omitempty"`
+	CompletionTime     string `json:"completionTime,omitempty"`
+	ReleasePipelineRun string `json:"releasePipelineRun,omitempty"`
+	Target             string `json:"target,omitempty"`
+}
+
+// getReleaseSummary returns the json representation of the summary of the given Release. If the Release is not done
+// yet, an empty string is returned.
+func getReleaseSummary(release *v1alpha1.Release) (string, error) {
+	if !release.IsDone() {
+		return "", nil
+	}
+
+	summary := releaseSummary{
+		Outcome:            v1alpha1.OutcomeLabelFailed,
+		ReleasePipelineRun: release.Status.ReleasePipelineRun,
+		Target:             release.Status.Target,
+	}
+	if release.HasSucceeded() {
+		summary.Outcome = v1alpha1.OutcomeLabelSucceeded
+	}
+	if condition := meta.FindStatusCondition(release.Status.Conditions, string(apis.ConditionSucceeded)); condition != nil {
+		summary.Reason = condition.Reason
+	}
+	if release.Status.CompletionTime != nil {
+		summary.CompletionTime = release.Status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// getCompletionEventAnnotations returns the machine-readable annotations of the event recorded once the given Release
+// finishes. They expose its outcome, target, release PipelineRun, duration and, if the given release PipelineRun
+// reported it, the digest of the released image. Fields that are not known are not included.
+func getCompletionEventAnnotations(release *v1alpha1.Release, pipelineRun *v1beta1.PipelineRun) map[string]string {
+	annotations := map[string]string{
+		v1alpha1.CompletionEventOutcomeAnnotation: v1alpha1.OutcomeLabelFailed,
+	}
+	if release.HasSucceeded() {
+		annotations[v1alpha1.CompletionEventOutcomeAnnotation] = v1alpha1.OutcomeLabelSucceeded
+	}
+	if release.Status.Target != "" {
+		annotations[v1alpha1.CompletionEventTargetAnnotation] = release.Status.Target
+	}
+	if release.Status.ReleasePipelineRun != "" {
+		annotations[v1alpha1.CompletionEventPipelineRunAnnotation] = release.Status.ReleasePipelineRun
+	}
+	if release.Status.StartTime != nil && release.Status.CompletionTime != nil {
+		duration := release.Status.CompletionTime.Sub(release.Status.StartTime.Time)
+		annotations[v1alpha1.CompletionEventDurationAnnotation] = strconv.FormatInt(int64(duration.Seconds()), 10)
+	}
+
+	if pipelineRun != nil {
+		for _, result := range pipelineRun.Status.PipelineResults {
+			if result.Name == imageDigestResult && result.Value.StringVal != "" {
+				annotations[v1alpha1.CompletionEventDigestAnnotation] = result.Value.StringVal
+			}
+		}
+	}
+
+	return annotations
+}
+
+// getUnknownPipelineTasks returns the sorted names of the given tasks that are not declared in the given Pipeline,
+// either as regular or finally tasks.
+func getUnknownPipelineTasks(pipeline *v1beta1.Pipeline, tasks map[string]string) []string {
+	pipelineTasks := make(map[string]bool, len(pipeline.Spec.Tasks)+len(pipeline.Spec.Finally))
+	for _, task := range pipeline.Spec.Tasks {
+		pipelineTasks[task.Name] = true
+	}
+	for _, task := range pipeline.Spec.Finally {
+		pipelineTasks[task.Name] = true
+	}
+
+	var unknownTasks []string
+	for task := range tasks {
+		if !pipelineTasks[task] {
+			unknownTasks = append(unknownTasks, task)
+		}
+	}
+	sort.Strings(unknownTasks)
+
+	return unknownTasks
+}
+
+// isPipelineBounded returns whether every regular and finally task of the given Pipeline sets a timeout, so a run of
+// the Pipeline can't last forever even if the PipelineRun doesn't set a timeout. A zero timeout disables the Tekton
+// timeout, so tasks setting it are considered unbounded.
+func isPipelineBounded(pipeline *v1beta1.Pipeline) bool {
+	if len(pipeline.Spec.Tasks) == 0 {
+		return false
+	}
+
+	isBounded := func(task v1beta1.PipelineTask) bool {
+		return task.Timeout != nil && task.Timeout.Duration > 0
+	}
+
+	for _, task := range pipeline.Spec.Tasks {
+		if !isBounded(task) {
+			return false
+		}
+	}
+	for _, task := range pipeline.Spec.Finally {
+		if !isBounded(task) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getReleasePipelineRunName returns a readable name for the release PipelineRun of the given Release that is unique
+// to it, in the form <release-name>-<hash>, so triggering it twice for the same Release converges on the same
+// PipelineRun instead of creating a duplicate. The hash is derived from the Release UID, as Releases from different
+// namespaces can share their name and target the same namespace. The Release name is truncated so the result fits in
+// a label value, as Tekton labels the TaskRuns with the name of their PipelineRun. If the Release has no UID yet, an
+// empty name is returned.
+func getReleasePipelineRunName(release *v1alpha1.Release) string {
+	if release.UID == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(release.UID))
+	suffix := fmt.Sprintf("%x", hash[:5])
+
+	prefix := release.Name
+	if maxPrefixLength := validation.LabelValueMaxLength - len(suffix) - 1; len(prefix) > maxPrefixLength {
+		prefix = prefix[:maxPrefixLength]
+	}
+	prefix = strings.TrimRight(prefix, "-.")
+	if prefix == "" {
+		prefix = releasePipelineRunPrefix
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, suffix)
+}
+
+// getPropagatedMetadataPrefixes returns the prefixes of the Release labels and annotations to copy to their release
+// PipelineRuns, read from the comma-separated RELEASE_PROPAGATED_METADATA_PREFIXES environment variable. If it's not
+// set, nil is returned, so no metadata is propagated.
+func getPropagatedMetadataPrefixes() []string {
+	var prefixes []string
+
+	for _, prefix := range strings.Split(os.Getenv("RELEASE_PROPAGATED_METADATA_PREFIXES"), ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// getNotificationAllowedHosts returns the hosts the notification channels of the Releases can point to, read from the
+// comma-separated NOTIFICATION_ALLOWED_HOSTS environment variable. If it's not set, nil is returned, so no
+// notification channels are notified.
+func getNotificationAllowedHosts() []string {
+	var hosts []string
+
+	for _, host := range strings.Split(os.Getenv("NOTIFICATION_ALLOWED_HOSTS"), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
+
+// getSourceAnnotations returns the annotations of the given Release whose names are listed in the comma-separated
+// RELEASE_SOURCE_ANNOTATIONS environment variable. If none of them is set in the Release, nil is returned.
+func getSourceAnnotations(release *v1alpha1.Release) map[string]string {
+	var sourceAnnotations map[string]string
+
+	for _, name := range strings.Split(os.Getenv("RELEASE_SOURCE_ANNOTATIONS"), ",") {
+		name = strings.TrimSpace(name)
+		if value, found := release.GetAnnotations()[name]; found && name != "" {
+			if sourceAnnotations == nil {
+				sourceAnnotations = make(map[string]string)
+			}
+			sourceAnnotations[name] = value
+		}
+	}
+
+	return sourceAnnotations
+}
+
+// getTektonAPIVersion returns the Tekton API version to use when creating release PipelineRuns. The value is read
+// from the TEKTON_API_VERSION environment variable, which is set by the --tekton-api-version flag, using v1beta1 if
+// it's not set.
+func getTektonAPIVersion() string {
+	apiVersion := os.Getenv("TEKTON_API_VERSION")
+	if apiVersion == "" {
+		return tekton.APIVersionV1beta1
+	}
+
+	return apiVersion
+}
+
+// isPipelineRunCancelled returns whether the given PipelineRun was requested to be cancelled or stopped through its
+// spec.status field.
+func isPipelineRunCancelled(pipelineRun *v1beta1.PipelineRun) bool {
+	return pipelineRun.IsCancelled() || pipelineRun.IsGracefullyCancelled() || pipelineRun.IsGracefullyStopped()
+}
+
+// isQuotaCheckEnabled returns whether the ResourceQuotas of the target namespace should be checked before creating a
+// release PipelineRun. The value is read from the RELEASE_PIPELINE_QUOTA_CHECK environment variable.
+func isQuotaCheckEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("RELEASE_PIPELINE_QUOTA_CHECK"))
+	return err == nil && enabled
+}
+
+// getWatchLabelSelector returns the label selector the Releases reconciled by the controller have to match. The
+// selector is read from the WATCH_LABEL_SELECTOR environment variable. If it's not set, all the Releases are
+// matched. An error is returned if the selector can't be parsed.
+func getWatchLabelSelector() (labels.Selector, error) {
+	return labels.Parse(os.Getenv("WATCH_LABEL_SELECTOR"))
+}
+
+// isPipelineRunDeduplicationEnabled returns whether a Release should adopt a running release PipelineRun identical to
+// the one it would create instead of creating a duplicate. The value is read from the DEDUPLICATE_PIPELINE_RUNS
+// environment variable.
+func isPipelineRunDeduplicationEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("DEDUPLICATE_PIPELINE_RUNS"))
+	return err == nil && enabled
+}
+
+// isPauseRequested returns whether the given Release has the pause annotation set to true.
+func isPauseRequested(release *v1alpha1.Release) bool {
+	paused, err := strconv.ParseBool(release.GetAnnotations()[v1alpha1.PauseAnnotation])
+	return err == nil && paused
+}
+
+// isMissingPipelineRunFailureEnabled returns whether a running Release whose release PipelineRun no longer exists
+// should be marked as failed instead of creating its release PipelineRun again. The value is read from the
+// RELEASE_PIPELINE_FAIL_ON_MISSING environment variable.
+func isMissingPipelineRunFailureEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("RELEASE_PIPELINE_FAIL_ON_MISSING"))
+	return err == nil && enabled
+}
+
+// getExhaustedQuota returns the name and resource of the first ResourceQuota in the given list with no headroom left
+// to create a PipelineRun or the Pods it needs. Empty strings are returned if there is enough headroom left.
+func getExhaustedQuota(resourceQuotas []corev1.ResourceQuota) (string, corev1.ResourceName) {
+	resourceNames := []corev1.ResourceName{
+		corev1.ResourcePods,
+		corev1.ResourceName("count/pipelineruns.tekton.dev"),
+	}
+
+	for _, resourceQuota := range resourceQuotas {
+		for _, resourceName := range resourceNames {
+			hard, hasHard := resourceQuota.Status.Hard[resourceName]
+			used, hasUsed := resourceQuota.Status.Used[resourceName]
+			if hasHard && hasUsed && used.Cmp(hard) >= 0 {
+				return resourceQuota.Name, resourceName
+			}
+		}
+	}
+
+	return "", ""
+}
+
+// isTargetOverrideAllowed returns whether Releases are allowed to override the target set in their ReleasePlan. The
+// value is read from the ALLOW_TARGET_OVERRIDE environment variable, which is set by the --allow-target-override flag.
+func isTargetOverrideAllowed() bool {
+	allowed, err := strconv.ParseBool(os.Getenv("ALLOW_TARGET_OVERRIDE"))
+	return err == nil && allowed
+}
+
+// isTargetFallbackEnabled returns whether the ReleasePlanAdmission of a Release can be found by matching its
+// application alone when no ReleasePlanAdmission matches the ReleasePlan target. The value is read from the
+// ENABLE_TARGET_FALLBACK environment variable, which is set by the --enable-target-fallback flag.
+func isTargetFallbackEnabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("ENABLE_TARGET_FALLBACK"))
+	return err == nil && enabled
+}
+
+// getMaxConcurrentPipelineRuns returns the maximum number of release PipelineRuns allowed to run at the same time in
+// a target namespace. The value is read from the RELEASE_PIPELINE_MAX_CONCURRENT environment variable. A value of zero
+// or lower means there is no limit.
+func getMaxConcurrentPipelineRuns() int {
+	return getEnvAsInt("RELEASE_PIPELINE_MAX_CONCURRENT", 0)
+}
+
+// getMaxPipelineRunsPerNamespace returns the maximum number of PipelineRuns managed by the release service, of any
+// type and from any ReleaseStrategy, allowed to run at the same time in a target namespace. The value is read from the
+// RELEASE_PIPELINE_MAX_PER_NAMESPACE environment variable. A value of zero or lower means there is no limit.
+func getMaxPipelineRunsPerNamespace() int {
+	return getEnvAsInt("RELEASE_PIPELINE_MAX_PER_NAMESPACE", 0)
+}
+
+// getParamValue returns the string representation of the value of the given param. Array params are represented
+// using their json encoding.
+func getParamValue(param v1alpha1.Params) string {
+	if len(param.Values) > 0 {
+		values, _ := json.Marshal(param.Values)
+		return string(values)
+	}
+
+	return param.Value
+}
+
+// getParamsDiff returns the list of params that were added, removed or changed in the current params compared to the
+// previous ones. Added and changed params are listed first, following the order of the current params, and removed
+// params are listed afterwards, following the order of the previous params.
+func getParamsDiff(previous, current []v1alpha1.Params) []v1alpha1.ParamDiff {
+	previousValues := make(map[string]string, len(previous))
+	for _, param := range previous {
+		previousValues[param.Name] = getParamValue(param)
+	}
+
+	var diff []v1alpha1.ParamDiff
+	currentNames := make(map[string]bool, len(current))
+	for _, param := range current {
+		currentNames[param.Name] = true
+		currentValue := getParamValue(param)
+		previousValue, found := previousValues[param.Name]
+		if !found || previousValue != currentValue {
+			diff = append(diff, v1alpha1.ParamDiff{
+				Name:     param.Name,
+				Previous: previousValue,
+				Current:  currentValue,
+			})
+		}
+	}
+
+	for _, param := range previous {
+		if !currentNames[param.Name] {
+			diff = append(diff, v1alpha1.ParamDiff{
+				Name:     param.Name,
+				Previous: previousValues[param.Name],
+			})
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,1004 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"github.com/redhat-appstudio/release-service/tekton"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Release Controller utils", func() {
+
+	Context("When getEnvAsInt is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("TEST_ENV_AS_INT")
+		})
+
+		It("should return the default value if the environment variable is not set", func() {
+			Expect(getEnvAsInt("TEST_ENV_AS_INT", 10)).To(Equal(10))
+		})
+
+		It("should return the default value if the environment variable is not a valid integer", func() {
+			os.Setenv("TEST_ENV_AS_INT", "foo")
+			Expect(getEnvAsInt("TEST_ENV_AS_INT", 10)).To(Equal(10))
+		})
+
+		It("should return the value of the environment variable if it is a valid integer", func() {
+			os.Setenv("TEST_ENV_AS_INT", "5")
+			Expect(getEnvAsInt("TEST_ENV_AS_INT", 10)).To(Equal(5))
+		})
+	})
+
+	Context("When getMaxParamsSize is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE")
+		})
+
+		It("should return the default maximum params size if the environment variable is not set", func() {
+			Expect(getMaxParamsSize()).To(Equal(defaultMaxParamsSize))
+		})
+
+		It("should return the maximum params size set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PARAMS_SIZE", "2048")
+			Expect(getMaxParamsSize()).To(Equal(2048))
+		})
+	})
+
+	Context("When getPipelineRunStatusPollInterval is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_STATUS_POLL_INTERVAL")
+		})
+
+		It("should return the default poll interval if the environment variable is not set", func() {
+			Expect(getPipelineRunStatusPollInterval()).To(Equal(defaultPipelineRunStatusPollInterval * time.Second))
+		})
+
+		It("should return the poll interval set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_STATUS_POLL_INTERVAL", "2")
+			Expect(getPipelineRunStatusPollInterval()).To(Equal(2 * time.Second))
+		})
+	})
+
+	Context("When getMissingPipelineRunCheckInterval is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_MISSING_CHECK_INTERVAL")
+		})
+
+		It("should return the default check interval if the environment variable is not set", func() {
+			Expect(getMissingPipelineRunCheckInterval()).To(Equal(defaultMissingPipelineRunCheckInterval * time.Second))
+		})
+
+		It("should return the check interval set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_MISSING_CHECK_INTERVAL", "60")
+			Expect(getMissingPipelineRunCheckInterval()).To(Equal(60 * time.Second))
+		})
+	})
+
+	Context("When getPipelineRunCreationBackoff is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_CREATION_BACKOFF")
+		})
+
+		It("should return the default backoff if the environment variable is not set", func() {
+			err := errors.NewTooManyRequests("", 0)
+			Expect(getPipelineRunCreationBackoff(err)).To(Equal(defaultPipelineRunCreationBackoff * time.Second))
+		})
+
+		It("should return the backoff set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_CREATION_BACKOFF", "30")
+			err := errors.NewTooManyRequests("", 0)
+			Expect(getPipelineRunCreationBackoff(err)).To(Equal(30 * time.Second))
+		})
+
+		It("should return the Retry-After hint if it's longer than the backoff", func() {
+			err := errors.NewTooManyRequests("", 60)
+			Expect(getPipelineRunCreationBackoff(err)).To(Equal(60 * time.Second))
+		})
+
+		It("should return the backoff if it's longer than the Retry-After hint", func() {
+			err := errors.NewTooManyRequests("", 1)
+			Expect(getPipelineRunCreationBackoff(err)).To(Equal(defaultPipelineRunCreationBackoff * time.Second))
+		})
+	})
+
+	Context("When newHeartbeat is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("HEARTBEAT_LEASE_NAMESPACE")
+		})
+
+		It("should return nil if no namespace is set for the lease", func() {
+			Expect(newHeartbeat(k8sClient)).To(BeNil())
+		})
+
+		It("should return a heartbeat if a namespace is set for the lease", func() {
+			os.Setenv("HEARTBEAT_LEASE_NAMESPACE", "default")
+			Expect(newHeartbeat(k8sClient)).NotTo(BeNil())
+		})
+	})
+
+	Context("When isPipelineRunCancelled is called", func() {
+		It("should return false if the PipelineRun spec.status is not set", func() {
+			Expect(isPipelineRunCancelled(&v1beta1.PipelineRun{})).To(BeFalse())
+		})
+
+		It("should return false if the PipelineRun is pending", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Spec.Status = v1beta1.PipelineRunSpecStatusPending
+			Expect(isPipelineRunCancelled(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return true if the PipelineRun was cancelled or stopped", func() {
+			for _, status := range []v1beta1.PipelineRunSpecStatus{
+				v1beta1.PipelineRunSpecStatusCancelled,
+				v1beta1.PipelineRunSpecStatusCancelledRunFinally,
+				v1beta1.PipelineRunSpecStatusStoppedRunFinally,
+			} {
+				pipelineRun := &v1beta1.PipelineRun{}
+				pipelineRun.Spec.Status = status
+				Expect(isPipelineRunCancelled(pipelineRun)).To(BeTrue())
+			}
+		})
+	})
+
+	Context("When getReleaseStrategyReference is called", func() {
+		It("should return an empty namespace if the reference has no namespace", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec:       v1alpha1.ReleaseSpec{ReleaseStrategy: "strategy"},
+			}
+			namespace, name := getReleaseStrategyReference(release)
+			Expect(namespace).To(BeEmpty())
+			Expect(name).To(Equal("strategy"))
+		})
+
+		It("should return the namespace and name in the reference", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec:       v1alpha1.ReleaseSpec{ReleaseStrategy: "shared/strategy"},
+			}
+			namespace, name := getReleaseStrategyReference(release)
+			Expect(namespace).To(Equal("shared"))
+			Expect(name).To(Equal("strategy"))
+		})
+	})
+
+	Context("When usesReleaseStrategyArtifact is called", func() {
+		var (
+			release              *v1alpha1.Release
+			releasePlanAdmission *v1alpha1.ReleasePlanAdmission
+		)
+
+		BeforeEach(func() {
+			release = &v1alpha1.Release{}
+			releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{
+				Spec: v1alpha1.ReleasePlanAdmissionSpec{
+					ReleaseStrategy:         "strategy",
+					ReleaseStrategyArtifact: "quay.io/redhat-appstudio/release-strategy:latest",
+				},
+			}
+		})
+
+		It("should return true if the ReleasePlanAdmission references an artifact", func() {
+			Expect(usesReleaseStrategyArtifact(release, releasePlanAdmission)).To(BeTrue())
+		})
+
+		It("should return false if the ReleasePlanAdmission doesn't reference an artifact", func() {
+			releasePlanAdmission.Spec.ReleaseStrategyArtifact = ""
+			Expect(usesReleaseStrategyArtifact(release, releasePlanAdmission)).To(BeFalse())
+		})
+
+		It("should return false if there is no ReleasePlanAdmission", func() {
+			Expect(usesReleaseStrategyArtifact(release, nil)).To(BeFalse())
+		})
+
+		It("should return false if the Release references a ReleaseStrategy", func() {
+			release.Spec.ReleaseStrategy = "strategy"
+			Expect(usesReleaseStrategyArtifact(release, releasePlanAdmission)).To(BeFalse())
+		})
+	})
+
+	Context("When isPauseRequested is called", func() {
+		It("should return true if the pause annotation is set to true", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{v1alpha1.PauseAnnotation: "true"},
+				},
+			}
+			Expect(isPauseRequested(release)).To(BeTrue())
+		})
+
+		It("should return false if the pause annotation is not set to true", func() {
+			release := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{v1alpha1.PauseAnnotation: "maybe"},
+				},
+			}
+			Expect(isPauseRequested(release)).To(BeFalse())
+			Expect(isPauseRequested(&v1alpha1.Release{})).To(BeFalse())
+		})
+	})
+
+	Context("When isReleaseStrategyNamespaceAllowed is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_STRATEGY_SHARED_NAMESPACES")
+		})
+
+		It("should not allow any namespace if no shared namespaces are set", func() {
+			Expect(isReleaseStrategyNamespaceAllowed("default")).To(BeFalse())
+			Expect(isReleaseStrategyNamespaceAllowed("shared")).To(BeFalse())
+		})
+
+		It("should only allow the shared namespaces set in the environment variable", func() {
+			os.Setenv("RELEASE_STRATEGY_SHARED_NAMESPACES", "foo, shared")
+			Expect(isReleaseStrategyNamespaceAllowed("shared")).To(BeTrue())
+			Expect(isReleaseStrategyNamespaceAllowed("other")).To(BeFalse())
+		})
+
+		It("should not allow an empty namespace", func() {
+			os.Setenv("RELEASE_STRATEGY_SHARED_NAMESPACES", "shared,")
+			Expect(isReleaseStrategyNamespaceAllowed("")).To(BeFalse())
+		})
+	})
+
+	Context("When isBundleRegistryAllowed is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("ALLOWED_BUNDLE_REGISTRIES")
+		})
+
+		It("should allow any registry if no allowed registries are set", func() {
+			Expect(isBundleRegistryAllowed("registry.example.com/bundle:latest")).To(BeTrue())
+		})
+
+		It("should allow releases that don't use a bundle", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io")
+			Expect(isBundleRegistryAllowed("")).To(BeTrue())
+		})
+
+		It("should only allow the registries set in the environment variable", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io, docker.io")
+			Expect(isBundleRegistryAllowed("quay.io/redhat-appstudio/bundle:latest")).To(BeTrue())
+			Expect(isBundleRegistryAllowed("redhat-appstudio/bundle:latest")).To(BeTrue())
+			Expect(isBundleRegistryAllowed("registry.example.com/bundle:latest")).To(BeFalse())
+			Expect(isBundleRegistryAllowed("quay.io.example.com/bundle:latest")).To(BeFalse())
+		})
+
+		It("should not allow bundles that can't be parsed", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io")
+			Expect(isBundleRegistryAllowed("quay.io/Invalid Reference")).To(BeFalse())
+		})
+	})
+
+	Context("When getDisallowedBundle is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("ALLOWED_BUNDLE_REGISTRIES")
+		})
+
+		It("should return the first bundle from a disallowed registry", func() {
+			os.Setenv("ALLOWED_BUNDLE_REGISTRIES", "quay.io")
+			releaseStrategy := &v1alpha1.ReleaseStrategy{
+				Spec: v1alpha1.ReleaseStrategySpec{Bundle: "quay.io/bundle:latest"},
+			}
+			collectors := []v1alpha1.Collector{
+				{Name: "allowed", Task: "task", Bundle: "quay.io/collector:latest"},
+				{Name: "disallowed", Task: "task", Bundle: "registry.example.com/collector:latest"},
+			}
+			Expect(getDisallowedBundle(releaseStrategy, collectors)).To(Equal("registry.example.com/collector:latest"))
+			Expect(getDisallowedBundle(releaseStrategy, collectors[:1])).To(BeEmpty())
+		})
+	})
+
+	Context("When getCollectorResults is called", func() {
+		It("should return the results of each collector sorted by name", func() {
+			taskRuns := []v1beta1.TaskRun{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"tekton.dev/pipelineTask": "scan"},
+					},
+					Status: v1beta1.TaskRunStatus{
+						TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+							TaskRunResults: []v1beta1.TaskRunResult{
+								{Name: "digests", Value: *v1beta1.NewStructuredValues("a", "b")},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"tekton.dev/pipelineTask": "sbom"},
+					},
+					Status: v1beta1.TaskRunStatus{
+						TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+							TaskRunResults: []v1beta1.TaskRunResult{
+								{Name: "url", Value: *v1beta1.NewStructuredValues("https://sbom")},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(getCollectorResults(taskRuns)).To(Equal([]v1alpha1.CollectorResult{
+				{Name: "sbom", Results: map[string]string{"url": "https://sbom"}},
+				{Name: "scan", Results: map[string]string{"digests": `["a","b"]`}},
+			}))
+		})
+
+		It("should return no results if there are no TaskRuns", func() {
+			Expect(getCollectorResults(nil)).To(BeEmpty())
+		})
+	})
+
+	Context("When getReleasePipelineRunName is called", func() {
+		It("should return an empty name if the Release has no UID", func() {
+			Expect(getReleasePipelineRunName(&v1alpha1.Release{})).To(BeEmpty())
+		})
+
+		It("should return a name unique to the Release", func() {
+			release := &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{
+				Name: "release",
+				UID:  "8b1a9953-c461-4a9c-8b63-0a27f5f8a316",
+			}}
+			otherRelease := &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{
+				Name: "release",
+				UID:  "fb8e20fc-2e4c-4b2a-9a3c-2f0d6f1b7c11",
+			}}
+
+			name := getReleasePipelineRunName(release)
+			Expect(name).To(MatchRegexp("^release-[0-9a-f]{10}$"))
+			Expect(getReleasePipelineRunName(release)).To(Equal(name))
+			Expect(getReleasePipelineRunName(otherRelease)).NotTo(Equal(name))
+		})
+
+		It("should truncate the Release name so the result fits in a label value", func() {
+			release := &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{
+				Name: strings.Repeat("a", 60),
+				UID:  "8b1a9953-c461-4a9c-8b63-0a27f5f8a316",
+			}}
+
+			name := getReleasePipelineRunName(release)
+			Expect(name).To(HaveLen(validation.LabelValueMaxLength))
+			Expect(name).To(MatchRegexp("^a{52}-[0-9a-f]{10}$"))
+		})
+
+		It("should not leave separators at the end of the truncated Release name", func() {
+			release := &v1alpha1.Release{ObjectMeta: metav1.ObjectMeta{
+				Name: strings.Repeat("a", 50) + "--b",
+				UID:  "8b1a9953-c461-4a9c-8b63-0a27f5f8a316",
+			}}
+
+			Expect(getReleasePipelineRunName(release)).To(MatchRegexp("^a{50}-[0-9a-f]{10}$"))
+		})
+	})
+
+	Context("When getReleaseSummary is called", func() {
+		It("should return an empty summary if the Release is not done", func() {
+			release := &v1alpha1.Release{}
+			release.MarkRunning()
+			Expect(getReleaseSummary(release)).To(BeEmpty())
+		})
+
+		It("should return the summary of a finished Release", func() {
+			release := &v1alpha1.Release{
+				Status: v1alpha1.ReleaseStatus{
+					ReleasePipelineRun: "target/release-pipelinerun",
+					Target:             "target",
+				},
+			}
+			release.MarkRunning()
+			release.MarkSucceeded()
+			release.Status.CompletionTime = &metav1.Time{Time: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+			Expect(getReleaseSummary(release)).To(Equal(`{"outcome":"succeeded","reason":"Succeeded",` +
+				`"completionTime":"2023-01-02T03:04:05Z","releasePipelineRun":"target/release-pipelinerun","target":"target"}`))
+		})
+	})
+
+	Context("When getCompletionEventAnnotations is called", func() {
+		It("should return the structured completion data of a finished Release", func() {
+			release := &v1alpha1.Release{
+				Status: v1alpha1.ReleaseStatus{
+					ReleasePipelineRun: "target/release-pipelinerun",
+					Target:             "target",
+				},
+			}
+			release.MarkRunning()
+			release.MarkSucceeded()
+			release.Status.StartTime = &metav1.Time{Time: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}
+			release.Status.CompletionTime = &metav1.Time{Time: time.Date(2023, 1, 2, 3, 6, 35, 0, time.UTC)}
+
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: "other", Value: *v1beta1.NewStructuredValues("value")},
+				{Name: imageDigestResult, Value: *v1beta1.NewStructuredValues("sha256:abc")},
+			}
+
+			Expect(getCompletionEventAnnotations(release, pipelineRun)).To(Equal(map[string]string{
+				v1alpha1.CompletionEventDigestAnnotation:      "sha256:abc",
+				v1alpha1.CompletionEventDurationAnnotation:    "150",
+				v1alpha1.CompletionEventOutcomeAnnotation:     v1alpha1.OutcomeLabelSucceeded,
+				v1alpha1.CompletionEventPipelineRunAnnotation: "target/release-pipelinerun",
+				v1alpha1.CompletionEventTargetAnnotation:      "target",
+			}))
+		})
+
+		It("should only return the outcome if nothing else is known", func() {
+			release := &v1alpha1.Release{}
+			release.MarkRunning()
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			release.Status.StartTime = nil
+
+			Expect(getCompletionEventAnnotations(release, nil)).To(Equal(map[string]string{
+				v1alpha1.CompletionEventOutcomeAnnotation: v1alpha1.OutcomeLabelFailed,
+			}))
+		})
+	})
+
+	Context("When getUnknownPipelineTasks is called", func() {
+		pipeline := &v1beta1.Pipeline{
+			Spec: v1beta1.PipelineSpec{
+				Tasks:   []v1beta1.PipelineTask{{Name: "build"}, {Name: "push"}},
+				Finally: []v1beta1.PipelineTask{{Name: "notify"}},
+			},
+		}
+
+		It("should return no tasks if all of them are declared in the Pipeline", func() {
+			Expect(getUnknownPipelineTasks(pipeline, map[string]string{"push": "pusher", "notify": "notifier"})).To(BeEmpty())
+		})
+
+		It("should return the sorted tasks not declared in the Pipeline", func() {
+			Expect(getUnknownPipelineTasks(pipeline, map[string]string{"sign": "signer", "push": "pusher", "scan": "scanner"})).
+				To(Equal([]string{"scan", "sign"}))
+		})
+	})
+
+	Context("When addReasonTransition is called", func() {
+		now := metav1.Now()
+
+		It("should accumulate the transitions in order", func() {
+			var history []v1alpha1.ReasonTransition
+			history = addReasonTransition(history, v1alpha1.ReasonTransition{Reason: "Pending", Time: now})
+			history = addReasonTransition(history, v1alpha1.ReasonTransition{Reason: "Running", Time: now})
+			history = addReasonTransition(history, v1alpha1.ReasonTransition{Reason: "Succeeded", Time: now})
+			Expect(history).To(HaveLen(3))
+			Expect(history[0].Reason).To(Equal("Pending"))
+			Expect(history[2].Reason).To(Equal("Succeeded"))
+		})
+
+		It("should not record the same reason twice in a row", func() {
+			history := []v1alpha1.ReasonTransition{{Reason: "Running", Time: now}}
+			history = addReasonTransition(history, v1alpha1.ReasonTransition{Reason: "Running", Time: metav1.Now()})
+			Expect(history).To(Equal([]v1alpha1.ReasonTransition{{Reason: "Running", Time: now}}))
+		})
+
+		It("should only keep the most recent transitions", func() {
+			var history []v1alpha1.ReasonTransition
+			for i := 0; i < maxReasonHistoryLength+5; i++ {
+				history = addReasonTransition(history, v1alpha1.ReasonTransition{Reason: fmt.Sprint(i), Time: now})
+			}
+			Expect(history).To(HaveLen(maxReasonHistoryLength))
+			Expect(history[0].Reason).To(Equal("5"))
+			Expect(history[maxReasonHistoryLength-1].Reason).To(Equal(fmt.Sprint(maxReasonHistoryLength + 4)))
+		})
+	})
+
+	Context("When getPipelineRunResults is called", func() {
+		It("should return the results indexed by name, storing the non-string ones in json format", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: "digest", Value: *v1beta1.NewStructuredValues("sha256:abc")},
+				{Name: "tags", Value: *v1beta1.NewStructuredValues("latest", "v1")},
+			}
+
+			Expect(getPipelineRunResults(pipelineRun)).To(Equal(map[string]string{
+				"digest": "sha256:abc",
+				"tags":   `["latest","v1"]`,
+			}))
+		})
+
+		It("should return an empty map if the PipelineRun has no results", func() {
+			Expect(getPipelineRunResults(&v1beta1.PipelineRun{})).To(BeEmpty())
+		})
+	})
+
+	Context("When getReleaseResults is called", func() {
+		It("should return the results in order, storing the non-string ones in json format", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			pipelineRun.Status.PipelineResults = []v1beta1.PipelineRunResult{
+				{Name: "tags", Value: *v1beta1.NewStructuredValues("latest", "v1")},
+				{Name: "digest", Value: *v1beta1.NewStructuredValues("sha256:abc")},
+			}
+
+			Expect(getReleaseResults(pipelineRun)).To(Equal([]v1alpha1.ReleaseResult{
+				{Name: "tags", Value: `["latest","v1"]`},
+				{Name: "digest", Value: "sha256:abc"},
+			}))
+		})
+
+		It("should return nil if the PipelineRun has no results", func() {
+			Expect(getReleaseResults(&v1beta1.PipelineRun{})).To(BeNil())
+		})
+	})
+
+	Context("When isPipelineBounded is called", func() {
+		timeout := &metav1.Duration{Duration: time.Hour}
+
+		It("should return true if all the tasks of the Pipeline set a timeout", func() {
+			Expect(isPipelineBounded(&v1beta1.Pipeline{
+				Spec: v1beta1.PipelineSpec{
+					Tasks:   []v1beta1.PipelineTask{{Name: "build", Timeout: timeout}, {Name: "push", Timeout: timeout}},
+					Finally: []v1beta1.PipelineTask{{Name: "notify", Timeout: timeout}},
+				},
+			})).To(BeTrue())
+		})
+
+		It("should return false if a finally task doesn't set a timeout", func() {
+			Expect(isPipelineBounded(&v1beta1.Pipeline{
+				Spec: v1beta1.PipelineSpec{
+					Tasks:   []v1beta1.PipelineTask{{Name: "build", Timeout: timeout}},
+					Finally: []v1beta1.PipelineTask{{Name: "notify"}},
+				},
+			})).To(BeFalse())
+		})
+
+		It("should return false if a task disables its timeout", func() {
+			Expect(isPipelineBounded(&v1beta1.Pipeline{
+				Spec: v1beta1.PipelineSpec{
+					Tasks: []v1beta1.PipelineTask{{Name: "build", Timeout: &metav1.Duration{}}},
+				},
+			})).To(BeFalse())
+		})
+
+		It("should return false if the Pipeline has no tasks", func() {
+			Expect(isPipelineBounded(&v1beta1.Pipeline{})).To(BeFalse())
+		})
+	})
+
+	Context("When getPropagatedMetadataPrefixes is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PROPAGATED_METADATA_PREFIXES")
+		})
+
+		It("should return nil if the environment variable is not set", func() {
+			Expect(getPropagatedMetadataPrefixes()).To(BeNil())
+		})
+
+		It("should return the trimmed prefixes listed in the environment variable, skipping empty ones", func() {
+			os.Setenv("RELEASE_PROPAGATED_METADATA_PREFIXES", "build.appstudio.openshift.io/, traceparent,,")
+			Expect(getPropagatedMetadataPrefixes()).To(Equal([]string{"build.appstudio.openshift.io/", "traceparent"}))
+		})
+	})
+
+	Context("When getNotificationAllowedHosts is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("NOTIFICATION_ALLOWED_HOSTS")
+		})
+
+		It("should return nil if the environment variable is not set", func() {
+			Expect(getNotificationAllowedHosts()).To(BeNil())
+		})
+
+		It("should return the trimmed hosts listed in the environment variable, skipping empty ones", func() {
+			os.Setenv("NOTIFICATION_ALLOWED_HOSTS", "hooks.slack.com, example.com,,")
+			Expect(getNotificationAllowedHosts()).To(Equal([]string{"hooks.slack.com", "example.com"}))
+		})
+	})
+
+	Context("When getSourceAnnotations is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_SOURCE_ANNOTATIONS")
+		})
+
+		release := &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"ci/build-number": "42",
+					"ci/build-url":    "https://ci.example.com/42",
+					"other":           "value",
+				},
+			},
+		}
+
+		It("should return nil if the environment variable is not set", func() {
+			Expect(getSourceAnnotations(release)).To(BeNil())
+		})
+
+		It("should return only the annotations listed in the environment variable", func() {
+			os.Setenv("RELEASE_SOURCE_ANNOTATIONS", "ci/build-number, ci/build-url,missing")
+			Expect(getSourceAnnotations(release)).To(Equal(map[string]string{
+				"ci/build-number": "42",
+				"ci/build-url":    "https://ci.example.com/42",
+			}))
+		})
+	})
+
+	Context("When getTektonAPIVersion is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("TEKTON_API_VERSION")
+		})
+
+		It("should return v1beta1 if the environment variable is not set", func() {
+			Expect(getTektonAPIVersion()).To(Equal(tekton.APIVersionV1beta1))
+		})
+
+		It("should return the API version set in the environment variable", func() {
+			os.Setenv("TEKTON_API_VERSION", tekton.APIVersionV1)
+			Expect(getTektonAPIVersion()).To(Equal(tekton.APIVersionV1))
+		})
+	})
+
+	Context("When isQuotaCheckEnabled is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_QUOTA_CHECK")
+		})
+
+		It("should return false if the environment variable is not set", func() {
+			Expect(isQuotaCheckEnabled()).To(BeFalse())
+		})
+
+		It("should return true if the environment variable is set to true", func() {
+			os.Setenv("RELEASE_PIPELINE_QUOTA_CHECK", "true")
+			Expect(isQuotaCheckEnabled()).To(BeTrue())
+		})
+	})
+
+	Context("When getWatchLabelSelector is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("WATCH_LABEL_SELECTOR")
+		})
+
+		It("should match every Release if the environment variable is not set", func() {
+			selector, err := getWatchLabelSelector()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.Empty()).To(BeTrue())
+		})
+
+		It("should return the selector set in the environment variable", func() {
+			os.Setenv("WATCH_LABEL_SELECTOR", "team=payments")
+			selector, err := getWatchLabelSelector()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.String()).To(Equal("team=payments"))
+		})
+
+		It("should return an error if the selector can't be parsed", func() {
+			os.Setenv("WATCH_LABEL_SELECTOR", "team in payments")
+			_, err := getWatchLabelSelector()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When isPipelineRunDeduplicationEnabled is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("DEDUPLICATE_PIPELINE_RUNS")
+		})
+
+		It("should return false if the environment variable is not set", func() {
+			Expect(isPipelineRunDeduplicationEnabled()).To(BeFalse())
+		})
+
+		It("should return true if the environment variable is set to true", func() {
+			os.Setenv("DEDUPLICATE_PIPELINE_RUNS", "true")
+			Expect(isPipelineRunDeduplicationEnabled()).To(BeTrue())
+		})
+	})
+
+	Context("When isMissingPipelineRunFailureEnabled is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_FAIL_ON_MISSING")
+		})
+
+		It("should return false if the environment variable is not set", func() {
+			Expect(isMissingPipelineRunFailureEnabled()).To(BeFalse())
+		})
+
+		It("should return true if the environment variable is set to true", func() {
+			os.Setenv("RELEASE_PIPELINE_FAIL_ON_MISSING", "true")
+			Expect(isMissingPipelineRunFailureEnabled()).To(BeTrue())
+		})
+	})
+
+	Context("When getExhaustedQuota is called", func() {
+		It("should return empty strings if there are no quotas", func() {
+			quotaName, resourceName := getExhaustedQuota(nil)
+			Expect(quotaName).To(BeEmpty())
+			Expect(resourceName).To(BeEmpty())
+		})
+
+		It("should return empty strings if the quotas have headroom", func() {
+			quotaName, resourceName := getExhaustedQuota([]corev1.ResourceQuota{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+					Status: corev1.ResourceQuotaStatus{
+						Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+						Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("9")},
+					},
+				},
+			})
+			Expect(quotaName).To(BeEmpty())
+			Expect(resourceName).To(BeEmpty())
+		})
+
+		It("should return the quota and resource with no headroom left", func() {
+			quotaName, resourceName := getExhaustedQuota([]corev1.ResourceQuota{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+					Status: corev1.ResourceQuotaStatus{
+						Hard: corev1.ResourceList{"count/pipelineruns.tekton.dev": resource.MustParse("5")},
+						Used: corev1.ResourceList{"count/pipelineruns.tekton.dev": resource.MustParse("5")},
+					},
+				},
+			})
+			Expect(quotaName).To(Equal("quota"))
+			Expect(resourceName).To(Equal(corev1.ResourceName("count/pipelineruns.tekton.dev")))
+		})
+	})
+
+	Context("When isTargetOverrideAllowed is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("ALLOW_TARGET_OVERRIDE")
+		})
+
+		It("should return false if the environment variable is not set", func() {
+			Expect(isTargetOverrideAllowed()).To(BeFalse())
+		})
+
+		It("should return false if the environment variable is not a valid boolean", func() {
+			os.Setenv("ALLOW_TARGET_OVERRIDE", "foo")
+			Expect(isTargetOverrideAllowed()).To(BeFalse())
+		})
+
+		It("should return true if the environment variable is set to true", func() {
+			os.Setenv("ALLOW_TARGET_OVERRIDE", "true")
+			Expect(isTargetOverrideAllowed()).To(BeTrue())
+		})
+	})
+
+	Context("When isTargetFallbackEnabled is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("ENABLE_TARGET_FALLBACK")
+		})
+
+		It("should return false if the environment variable is not set", func() {
+			Expect(isTargetFallbackEnabled()).To(BeFalse())
+		})
+
+		It("should return true if the environment variable is set to true", func() {
+			os.Setenv("ENABLE_TARGET_FALLBACK", "true")
+			Expect(isTargetFallbackEnabled()).To(BeTrue())
+		})
+	})
+
+	Context("When getFailureLogLines is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_FAILURE_LOG_LINES")
+		})
+
+		It("should return the default number of lines if the environment variable is not set", func() {
+			Expect(getFailureLogLines()).To(Equal(defaultFailureLogLines))
+		})
+
+		It("should return the number of lines set in the environment variable", func() {
+			os.Setenv("RELEASE_FAILURE_LOG_LINES", "50")
+			Expect(getFailureLogLines()).To(Equal(50))
+		})
+	})
+
+	Context("When getFailedPipelineRunRetention is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_FAILED_RETENTION")
+		})
+
+		It("should return zero if the environment variable is not set", func() {
+			Expect(getFailedPipelineRunRetention()).To(BeZero())
+		})
+
+		It("should return the retention set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_FAILED_RETENTION", "900")
+			Expect(getFailedPipelineRunRetention()).To(Equal(15 * time.Minute))
+		})
+	})
+
+	Context("When getDefaultPipelineRunTimeout is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT")
+		})
+
+		It("should return the default timeout if the environment variable is not set", func() {
+			Expect(getDefaultPipelineRunTimeout()).To(Equal(defaultPipelineRunTimeout * time.Second))
+		})
+
+		It("should return the timeout set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT", "7200")
+			Expect(getDefaultPipelineRunTimeout()).To(Equal(2 * time.Hour))
+		})
+
+		It("should return zero if the default timeout is disabled in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_DEFAULT_TIMEOUT", "0")
+			Expect(getDefaultPipelineRunTimeout()).To(BeZero())
+		})
+	})
+
+	Context("When getReleasePlanAdmissionWaitTimeout is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PLAN_ADMISSION_WAIT_TIMEOUT")
+		})
+
+		It("should return the default timeout if the environment variable is not set", func() {
+			Expect(getReleasePlanAdmissionWaitTimeout()).To(Equal(defaultReleasePlanAdmissionWaitTimeout * time.Second))
+		})
+
+		It("should return the timeout set in the environment variable", func() {
+			os.Setenv("RELEASE_PLAN_ADMISSION_WAIT_TIMEOUT", "600")
+			Expect(getReleasePlanAdmissionWaitTimeout()).To(Equal(10 * time.Minute))
+		})
+	})
+
+	Context("When getMissingReleasePlanAdmissionRequeueDelay is called", func() {
+		It("should return the minimum delay for new Releases", func() {
+			Expect(getMissingReleasePlanAdmissionRequeueDelay(time.Second, time.Hour)).To(Equal(releasePlanAdmissionMinRequeueDelay))
+		})
+
+		It("should return the time the Release has already waited", func() {
+			Expect(getMissingReleasePlanAdmissionRequeueDelay(time.Minute, time.Hour)).To(Equal(time.Minute))
+		})
+
+		It("should not exceed the time left before the timeout", func() {
+			Expect(getMissingReleasePlanAdmissionRequeueDelay(4*time.Minute, 5*time.Minute)).To(Equal(time.Minute))
+		})
+	})
+
+	Context("When isMissingReleasePlanAdmissionError is called", func() {
+		It("should return true for NotFound errors", func() {
+			Expect(isMissingReleasePlanAdmissionError(errors.NewNotFound(schema.GroupResource{}, "release-plan"))).To(BeTrue())
+		})
+
+		It("should return true if no ReleasePlanAdmission was found", func() {
+			Expect(isMissingReleasePlanAdmissionError(fmt.Errorf("no ReleasePlanAdmission found for application 'app'"))).To(BeTrue())
+		})
+
+		It("should return false for other errors", func() {
+			Expect(isMissingReleasePlanAdmissionError(fmt.Errorf("multiple ReleasePlanAdmissions found"))).To(BeFalse())
+		})
+	})
+
+	Context("When getReleasePlanAdmissionErrorReason is called", func() {
+		It("should return ReleasePlanNotFound for NotFound errors", func() {
+			Expect(getReleasePlanAdmissionErrorReason(errors.NewNotFound(schema.GroupResource{}, "release-plan"))).To(
+				Equal(v1alpha1.ReleaseReasonReleasePlanNotFound))
+		})
+
+		It("should return ReleasePlanAdmissionNotFound if no ReleasePlanAdmission was found", func() {
+			Expect(getReleasePlanAdmissionErrorReason(fmt.Errorf("no ReleasePlanAdmission found for application 'app'"))).To(
+				Equal(v1alpha1.ReleaseReasonReleasePlanAdmissionNotFound))
+		})
+
+		It("should return ReleasePlanValidationError for other errors", func() {
+			Expect(getReleasePlanAdmissionErrorReason(fmt.Errorf("multiple ReleasePlanAdmissions found"))).To(
+				Equal(v1alpha1.ReleaseReasonReleasePlanValidationError))
+		})
+	})
+
+	Context("When getReleaseStrategyErrorReason is called", func() {
+		var releasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{}
+		})
+
+		It("should return ReleaseStrategyArtifactError if the ReleaseStrategy is read from an artifact", func() {
+			releasePlanAdmission.Spec.ReleaseStrategyArtifact = "quay.io/redhat-appstudio/release-strategy:latest"
+			Expect(getReleaseStrategyErrorReason(&v1alpha1.Release{}, releasePlanAdmission,
+				errors.NewNotFound(schema.GroupResource{}, "strategy"))).To(Equal(v1alpha1.ReleaseReasonReleaseStrategyArtifactError))
+		})
+
+		It("should return ReleaseStrategyNotFound for NotFound errors", func() {
+			Expect(getReleaseStrategyErrorReason(&v1alpha1.Release{}, releasePlanAdmission,
+				errors.NewNotFound(schema.GroupResource{}, "strategy"))).To(Equal(v1alpha1.ReleaseReasonReleaseStrategyNotFound))
+		})
+
+		It("should return ValidationError for other errors", func() {
+			Expect(getReleaseStrategyErrorReason(&v1alpha1.Release{}, releasePlanAdmission,
+				fmt.Errorf("connection refused"))).To(Equal(v1alpha1.ReleaseReasonValidationError))
+		})
+	})
+
+	Context("When getMaxConcurrentPipelineRuns is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_MAX_CONCURRENT")
+		})
+
+		It("should return zero if the environment variable is not set", func() {
+			Expect(getMaxConcurrentPipelineRuns()).To(Equal(0))
+		})
+
+		It("should return the maximum number of concurrent pipelineRuns set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_CONCURRENT", "3")
+			Expect(getMaxConcurrentPipelineRuns()).To(Equal(3))
+		})
+	})
+
+	Context("When getMaxPipelineRunsPerNamespace is called", func() {
+		AfterEach(func() {
+			os.Unsetenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE")
+		})
+
+		It("should return zero if the environment variable is not set", func() {
+			Expect(getMaxPipelineRunsPerNamespace()).To(Equal(0))
+		})
+
+		It("should return the maximum number of pipelineRuns per namespace set in the environment variable", func() {
+			os.Setenv("RELEASE_PIPELINE_MAX_PER_NAMESPACE", "5")
+			Expect(getMaxPipelineRunsPerNamespace()).To(Equal(5))
+		})
+	})
+
+	Context("When getParamsDiff is called", func() {
+		It("should return no changes if the params are the same", func() {
+			params := []v1alpha1.Params{
+				{Name: "foo", Value: "bar"},
+				{Name: "list", Values: []string{"a", "b"}},
+			}
+			Expect(getParamsDiff(params, params)).To(BeEmpty())
+		})
+
+		It("should return the added, changed and removed params", func() {
+			previous := []v1alpha1.Params{
+				{Name: "changed", Value: "old"},
+				{Name: "removed", Value: "value"},
+				{Name: "unchanged", Value: "value"},
+			}
+			current := []v1alpha1.Params{
+				{Name: "added", Value: "value"},
+				{Name: "changed", Value: "new"},
+				{Name: "unchanged", Value: "value"},
+			}
+			Expect(getParamsDiff(previous, current)).To(Equal([]v1alpha1.ParamDiff{
+				{Name: "added", Current: "value"},
+				{Name: "changed", Previous: "old", Current: "new"},
+				{Name: "removed", Previous: "value"},
+			}))
+		})
+
+		It("should compare the values of array params", func() {
+			previous := []v1alpha1.Params{{Name: "list", Values: []string{"a"}}}
+			current := []v1alpha1.Params{{Name: "list", Values: []string{"a", "b"}}}
+			Expect(getParamsDiff(previous, current)).To(Equal([]v1alpha1.ParamDiff{
+				{Name: "list", Previous: `["a"]`, Current: `["a","b"]`},
+			}))
+		})
+
+		It("should return all the params as added if there are no previous params", func() {
+			current := []v1alpha1.Params{{Name: "foo", Value: "bar"}}
+			Expect(getParamsDiff(nil, current)).To(Equal([]v1alpha1.ParamDiff{
+				{Name: "foo", Current: "bar"},
+			}))
+		})
+	})
+})
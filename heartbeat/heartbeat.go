@@ -0,0 +1,124 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// holderIdentity is the identity set in the heartbeat Lease
+	holderIdentity = "release-service"
+
+	// LastReleaseAnnotation is the annotation holding the namespaced name of the last processed Release
+	LastReleaseAnnotation = "release.appstudio.openshift.io/last-release"
+
+	// LastReleaseOutcomeAnnotation is the annotation holding the outcome of the last processed Release
+	LastReleaseOutcomeAnnotation = "release.appstudio.openshift.io/last-release-outcome"
+)
+
+// Heartbeat maintains a Lease that is renewed as Releases get reconciled, so external dashboards can tell that the
+// controller is alive and which was the last Release it processed.
+type Heartbeat struct {
+	client     client.Client
+	interval   time.Duration
+	lastUpdate time.Time
+	mutex      sync.Mutex
+	name       string
+	namespace  string
+}
+
+// NewHeartbeat creates a new Heartbeat that maintains the Lease with the given name and namespace. The Lease will be
+// updated at most once per interval.
+func NewHeartbeat(client client.Client, name, namespace string, interval time.Duration) *Heartbeat {
+	return &Heartbeat{
+		client:    client,
+		interval:  interval,
+		name:      name,
+		namespace: namespace,
+	}
+}
+
+// Beat renews the heartbeat Lease, recording the given Release as the last processed one. If the Lease was updated
+// less than an interval ago, no action will be taken. Calling Beat on a nil Heartbeat is a no-op, so it can be used
+// to disable the heartbeat.
+func (h *Heartbeat) Beat(ctx context.Context, release *v1alpha1.Release) error {
+	if h == nil {
+		return nil
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	now := time.Now()
+	if !h.lastUpdate.IsZero() && now.Sub(h.lastUpdate) < h.interval {
+		return nil
+	}
+
+	lease := &coordinationv1.Lease{}
+	err := h.client.Get(ctx, types.NamespacedName{Name: h.name, Namespace: h.namespace}, lease)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	identity := holderIdentity
+	renewTime := metav1.NewMicroTime(now)
+	lease.Name = h.name
+	lease.Namespace = h.namespace
+	lease.Spec.HolderIdentity = &identity
+	lease.Spec.RenewTime = &renewTime
+	lease.SetAnnotations(map[string]string{
+		LastReleaseAnnotation:        fmt.Sprintf("%s%c%s", release.Namespace, types.Separator, release.Name),
+		LastReleaseOutcomeAnnotation: getReleaseOutcome(release),
+	})
+
+	if errors.IsNotFound(err) {
+		err = h.client.Create(ctx, lease)
+	} else {
+		err = h.client.Update(ctx, lease)
+	}
+	if err != nil {
+		return err
+	}
+
+	h.lastUpdate = now
+
+	return nil
+}
+
+// getReleaseOutcome returns a string describing the outcome of the given Release.
+func getReleaseOutcome(release *v1alpha1.Release) string {
+	if !release.IsDone() {
+		return "InProgress"
+	}
+
+	if release.HasSucceeded() {
+		return "Succeeded"
+	}
+
+	return "Failed"
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package heartbeat
+
+import (
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Heartbeat", func() {
+	const (
+		leaseName = "heartbeat"
+		namespace = "default"
+	)
+
+	var release *v1alpha1.Release
+
+	getLease := func() *coordinationv1.Lease {
+		lease := &coordinationv1.Lease{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: leaseName, Namespace: namespace}, lease)).To(Succeed())
+		return lease
+	}
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: namespace,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		_ = k8sClient.Delete(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      leaseName,
+				Namespace: namespace,
+			},
+		})
+	})
+
+	Context("When NewHeartbeat is called", func() {
+		It("creates and return a new heartbeat", func() {
+			Expect(reflect.TypeOf(NewHeartbeat(k8sClient, leaseName, namespace, time.Minute))).To(Equal(reflect.TypeOf(&Heartbeat{})))
+		})
+	})
+
+	Context("When Beat is called", func() {
+		It("does nothing if the heartbeat is nil", func() {
+			var heartbeat *Heartbeat
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+		})
+
+		It("creates the lease recording the last processed release", func() {
+			heartbeat := NewHeartbeat(k8sClient, leaseName, namespace, time.Minute)
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+
+			lease := getLease()
+			Expect(*lease.Spec.HolderIdentity).To(Equal(holderIdentity))
+			Expect(lease.Spec.RenewTime).NotTo(BeNil())
+			Expect(lease.Annotations[LastReleaseAnnotation]).To(Equal("default/release"))
+			Expect(lease.Annotations[LastReleaseOutcomeAnnotation]).To(Equal("InProgress"))
+		})
+
+		It("doesn't update the lease again until the interval has passed", func() {
+			heartbeat := NewHeartbeat(k8sClient, leaseName, namespace, time.Minute)
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+			renewTime := getLease().Spec.RenewTime
+
+			release.Name = "other-release"
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+
+			lease := getLease()
+			Expect(lease.Spec.RenewTime.Equal(renewTime)).To(BeTrue())
+			Expect(lease.Annotations[LastReleaseAnnotation]).To(Equal("default/release"))
+		})
+
+		It("updates the lease once the interval has passed", func() {
+			heartbeat := NewHeartbeat(k8sClient, leaseName, namespace, time.Minute)
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+			heartbeat.lastUpdate = heartbeat.lastUpdate.Add(-time.Minute)
+
+			release.Name = "other-release"
+			release.MarkRunning()
+			release.MarkSucceeded()
+			Expect(heartbeat.Beat(ctx, release)).To(Succeed())
+
+			lease := getLease()
+			Expect(lease.Annotations[LastReleaseAnnotation]).To(Equal("default/other-release"))
+			Expect(lease.Annotations[LastReleaseOutcomeAnnotation]).To(Equal("Succeeded"))
+		})
+	})
+
+	Context("When getReleaseOutcome is called", func() {
+		It("returns the outcome of the release", func() {
+			Expect(getReleaseOutcome(release)).To(Equal("InProgress"))
+			release.MarkRunning()
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "")
+			Expect(getReleaseOutcome(release)).To(Equal("Failed"))
+		})
+	})
+})
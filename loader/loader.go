@@ -3,6 +3,8 @@ package loader
 import (
 	"context"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
@@ -10,21 +12,43 @@ import (
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/tekton"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// ReleasePlanAdmissionSelectionError is the selection policy failing when more than one ReleasePlanAdmission
+	// matches a ReleasePlan, as the configuration is ambiguous.
+	ReleasePlanAdmissionSelectionError = "error"
+
+	// ReleasePlanAdmissionSelectionByName is the selection policy picking the first of the ReleasePlanAdmissions
+	// matching a ReleasePlan when sorted by namespace and name.
+	ReleasePlanAdmissionSelectionByName = "name"
+)
+
 type ObjectLoader interface {
 	GetActiveReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
 	GetActiveReleasePlanAdmissionFromRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlanAdmission, error)
 	GetApplication(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*applicationapiv1alpha1.Application, error)
 	GetApplicationComponents(ctx context.Context, cli client.Client, application *applicationapiv1alpha1.Application) ([]applicationapiv1alpha1.Component, error)
+	GetCollectorsPipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1beta1.PipelineRun, error)
 	GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*ecapiv1alpha1.EnterpriseContractPolicy, error)
 	GetEnvironment(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*applicationapiv1alpha1.Environment, error)
+	GetPersistentVolumeClaim(ctx context.Context, cli client.Client, name, namespace string) (*corev1.PersistentVolumeClaim, error)
+	GetPreviousSuccessfulRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.Release, error)
 	GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error)
 	GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1beta1.PipelineRun, error)
+	GetReleasePipelineRunTaskRuns(ctx context.Context, cli client.Client, pipelineRun *v1beta1.PipelineRun) ([]v1beta1.TaskRun, error)
 	GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error)
+	GetReleasePlanAdmissionByApplication(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
+	GetReleasePipeline(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*v1beta1.Pipeline, error)
 	GetReleaseStrategy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseStrategy, error)
+	GetReleaseStrategyByName(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseStrategy, error)
+	GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) ([]corev1.ResourceQuota, error)
+	GetRunningManagedPipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error)
+	GetRunningReleasePipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error)
 	GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error)
 	GetSnapshotEnvironmentBinding(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error)
 	GetSnapshotEnvironmentBindingFromReleaseStatus(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.SnapshotEnvironmentBinding, error)
@@ -50,7 +74,9 @@ func getObject(name, namespace string, cli client.Client, ctx context.Context, o
 // Only ReleasePlanAdmissions with the 'auto-release' label set to true (or missing the label, which is
 // treated the same as having the label and it being set to true) will be searched for. If a matching
 // ReleasePlanAdmission is not found or the List operation fails, an error will be returned. If more than
-// one matching ReleasePlanAdmission objects is found, an error will be returned.
+// one matching ReleasePlanAdmission objects is found, an error will be returned unless the selection policy
+// picks them by name. If the target namespace doesn't exist, a different error will be returned so both
+// cases can be told apart.
 func (l *loader) GetActiveReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error) {
 	releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
 	err := cli.List(ctx, releasePlanAdmissions,
@@ -60,44 +86,55 @@ func (l *loader) GetActiveReleasePlanAdmission(ctx context.Context, cli client.C
 		return nil, err
 	}
 
-	var activeReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
-
-	for i, releasePlanAdmission := range releasePlanAdmissions.Items {
-		if releasePlanAdmission.Spec.Application != releasePlan.Spec.Application {
-			continue
-		}
-
-		if activeReleasePlanAdmission != nil {
-			return nil, fmt.Errorf("multiple ReleasePlanAdmissions found with the target (%+v) for application '%s'",
-				releasePlan.Spec.Target, releasePlan.Spec.Application)
+	var candidates []v1alpha1.ReleasePlanAdmission
+	for _, releasePlanAdmission := range releasePlanAdmissions.Items {
+		if releasePlanAdmission.Spec.Application == releasePlan.Spec.Application {
+			candidates = append(candidates, releasePlanAdmission)
 		}
+	}
 
-		labelValue, found := releasePlanAdmission.GetLabels()[v1alpha1.AutoReleaseLabel]
-		if found && labelValue == "false" {
-			return nil, fmt.Errorf("found ReleasePlanAdmission '%s' with auto-release label set to false",
-				releasePlanAdmission.Name)
-		}
-		activeReleasePlanAdmission = &releasePlanAdmissions.Items[i]
+	activeReleasePlanAdmission, ambiguous := selectReleasePlanAdmission(candidates)
+	if ambiguous {
+		return nil, fmt.Errorf("multiple ReleasePlanAdmissions found with the target (%+v) for application '%s'",
+			releasePlan.Spec.Target, releasePlan.Spec.Application)
 	}
 
 	if activeReleasePlanAdmission == nil {
+		err = getObject(releasePlan.Spec.Target, "", cli, ctx, &corev1.Namespace{})
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("target namespace (%+v) not found for application '%s'",
+				releasePlan.Spec.Target, releasePlan.Spec.Application)
+		}
+
 		return nil, fmt.Errorf("no ReleasePlanAdmission found in the target (%+v) for application '%s'",
 			releasePlan.Spec.Target, releasePlan.Spec.Application)
 	}
 
+	labelValue, found := activeReleasePlanAdmission.GetLabels()[v1alpha1.AutoReleaseLabel]
+	if found && labelValue == "false" {
+		return nil, fmt.Errorf("found ReleasePlanAdmission '%s' with auto-release label set to false",
+			activeReleasePlanAdmission.Name)
+	}
+
 	return activeReleasePlanAdmission, nil
 }
 
 // GetActiveReleasePlanAdmissionFromRelease returns the ReleasePlanAdmission targeted by the ReleasePlan referenced by
 // the given Release. Only ReleasePlanAdmissions with the 'auto-release' label set to true (or missing the label, which
 // is treated the same as having the label and it being set to true) will be searched for. If a matching
-// ReleasePlanAdmission is not found or the List operation fails, an error will be returned.
+// ReleasePlanAdmission is not found or the List operation fails, an error will be returned. If the Release overrides
+// its target, the ReleasePlanAdmission will be searched for in the override namespace instead.
 func (l *loader) GetActiveReleasePlanAdmissionFromRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlanAdmission, error) {
 	releasePlan, err := l.GetReleasePlan(ctx, cli, release)
 	if err != nil {
 		return nil, err
 	}
 
+	if release.Spec.OverrideTarget != "" {
+		releasePlan = releasePlan.DeepCopy()
+		releasePlan.Spec.Target = release.Spec.OverrideTarget
+	}
+
 	return l.GetActiveReleasePlanAdmission(ctx, cli, releasePlan)
 }
 
@@ -121,6 +158,24 @@ func (l *loader) GetApplicationComponents(ctx context.Context, cli client.Client
 	return applicationComponents.Items, nil
 }
 
+// GetCollectorsPipelineRun returns the PipelineRun running the collectors of the given Release. If this PipelineRun is
+// not found or the List operation fails, an error will be returned.
+func (l *loader) GetCollectorsPipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1beta1.PipelineRun, error) {
+	pipelineRuns := &v1beta1.PipelineRunList{}
+	err := cli.List(ctx, pipelineRuns,
+		client.Limit(1),
+		client.MatchingLabels{
+			tekton.PipelinesTypeLabel:    tekton.PipelineTypeCollectors,
+			tekton.ReleaseNameLabel:      release.Name,
+			tekton.ReleaseNamespaceLabel: release.Namespace,
+		})
+	if err == nil && len(pipelineRuns.Items) > 0 {
+		return &pipelineRuns.Items[0], nil
+	}
+
+	return nil, err
+}
+
 // GetEnterpriseContractPolicy returns the EnterpriseContractPolicy referenced by the given ReleaseStrategy. If the
 // EnterpriseContractPolicy is not found or the Get operation fails, an error is returned.
 func (l *loader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
@@ -135,6 +190,38 @@ func (l *loader) GetEnvironment(ctx context.Context, cli client.Client, releaseP
 	return environment, getObject(releasePlanAdmission.Spec.Environment, releasePlanAdmission.Namespace, cli, ctx, environment)
 }
 
+// GetPersistentVolumeClaim returns the PersistentVolumeClaim with the given name and namespace. If the
+// PersistentVolumeClaim is not found or the Get operation fails, an error will be returned.
+func (l *loader) GetPersistentVolumeClaim(ctx context.Context, cli client.Client, name, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	persistentVolumeClaim := &corev1.PersistentVolumeClaim{}
+	return persistentVolumeClaim, getObject(name, namespace, cli, ctx, persistentVolumeClaim)
+}
+
+// GetPreviousSuccessfulRelease returns the most recently completed successful Release using the same ReleasePlan as
+// the given Release or nil if there is none. In the case the List operation fails, an error will be returned.
+func (l *loader) GetPreviousSuccessfulRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.Release, error) {
+	releases := &v1alpha1.ReleaseList{}
+	err := cli.List(ctx, releases, client.InNamespace(release.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	var previousRelease *v1alpha1.Release
+	for i := range releases.Items {
+		candidate := &releases.Items[i]
+		if candidate.Name == release.Name || candidate.Spec.ReleasePlan != release.Spec.ReleasePlan ||
+			!candidate.HasSucceeded() || candidate.Status.CompletionTime == nil {
+			continue
+		}
+
+		if previousRelease == nil || candidate.Status.CompletionTime.After(previousRelease.Status.CompletionTime.Time) {
+			previousRelease = candidate
+		}
+	}
+
+	return previousRelease, nil
+}
+
 // GetRelease returns the Release with the given name and namespace. If the Release is not found or the Get operation
 // fails, an error will be returned.
 func (l *loader) GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error) {
@@ -142,21 +229,58 @@ func (l *loader) GetRelease(ctx context.Context, cli client.Client, name, namesp
 	return release, getObject(name, namespace, cli, ctx, release)
 }
 
-// GetReleasePipelineRun returns the PipelineRun referenced by the given Release or nil if it's not found. In the case
-// the List operation fails, an error will be returned.
+// GetReleasePipelineRun returns the PipelineRun referenced by the given Release or nil if it's not found. Releases
+// that adopted the PipelineRun of another Release are only linked to it through their status, so if no PipelineRun is
+// labeled for the Release, the one referenced in its status is returned. In the case the List or Get operations fail,
+// an error will be returned.
 func (l *loader) GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1beta1.PipelineRun, error) {
 	pipelineRuns := &v1beta1.PipelineRunList{}
 	err := cli.List(ctx, pipelineRuns,
 		client.Limit(1),
 		client.MatchingLabels{
+			tekton.PipelinesTypeLabel:    tekton.PipelineTypeRelease,
 			tekton.ReleaseNameLabel:      release.Name,
 			tekton.ReleaseNamespaceLabel: release.Namespace,
 		})
-	if err == nil && len(pipelineRuns.Items) > 0 {
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pipelineRuns.Items) > 0 {
 		return &pipelineRuns.Items[0], nil
 	}
 
-	return nil, err
+	pipelineRunNamespacedName := strings.Split(release.Status.ReleasePipelineRun, string(types.Separator))
+	if len(pipelineRunNamespacedName) != 2 {
+		return nil, nil
+	}
+
+	pipelineRun := &v1beta1.PipelineRun{}
+	err = getObject(pipelineRunNamespacedName[1], pipelineRunNamespacedName[0], cli, ctx, pipelineRun)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return pipelineRun, nil
+}
+
+// GetReleasePipelineRunTaskRuns returns the TaskRuns created for the given PipelineRun. If the List operation fails, an
+// error will be returned.
+func (l *loader) GetReleasePipelineRunTaskRuns(ctx context.Context, cli client.Client, pipelineRun *v1beta1.PipelineRun) ([]v1beta1.TaskRun, error) {
+	taskRuns := &v1beta1.TaskRunList{}
+	err := cli.List(ctx, taskRuns,
+		client.InNamespace(pipelineRun.Namespace),
+		client.MatchingLabels{
+			"tekton.dev/pipelineRun": pipelineRun.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskRuns.Items, nil
 }
 
 // GetReleasePlan returns the ReleasePlan referenced by the given Release. If the ReleasePlan is not found or
@@ -166,6 +290,54 @@ func (l *loader) GetReleasePlan(ctx context.Context, cli client.Client, release
 	return releasePlan, getObject(release.Spec.ReleasePlan, release.Namespace, cli, ctx, releasePlan)
 }
 
+// GetReleasePlanAdmissionByApplication returns the ReleasePlanAdmission accepting releases of the application of the
+// given ReleasePlan from its namespace, regardless of the namespace it's in. This allows finding the ReleasePlanAdmission
+// of a ReleasePlan whose target doesn't match any namespace. Only ReleasePlanAdmissions with the 'auto-release' label
+// set to true (or missing the label) will be returned. If no matching ReleasePlanAdmission is found, more than one are
+// found and the selection policy doesn't pick them by name, or the List operation fails, an error will be returned.
+func (l *loader) GetReleasePlanAdmissionByApplication(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error) {
+	releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
+	err := cli.List(ctx, releasePlanAdmissions,
+		client.MatchingFields{"spec.origin": releasePlan.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []v1alpha1.ReleasePlanAdmission
+	for _, releasePlanAdmission := range releasePlanAdmissions.Items {
+		if releasePlanAdmission.Spec.Application != releasePlan.Spec.Application {
+			continue
+		}
+
+		labelValue, found := releasePlanAdmission.GetLabels()[v1alpha1.AutoReleaseLabel]
+		if found && labelValue == "false" {
+			continue
+		}
+
+		candidates = append(candidates, releasePlanAdmission)
+	}
+
+	matchingReleasePlanAdmission, ambiguous := selectReleasePlanAdmission(candidates)
+	if ambiguous {
+		return nil, fmt.Errorf("multiple ReleasePlanAdmissions found for application '%s'",
+			releasePlan.Spec.Application)
+	}
+
+	if matchingReleasePlanAdmission == nil {
+		return nil, fmt.Errorf("no ReleasePlanAdmission found for application '%s'", releasePlan.Spec.Application)
+	}
+
+	return matchingReleasePlanAdmission, nil
+}
+
+// GetReleasePipeline returns the Pipeline referenced by the given ReleaseStrategy, looking it up in the namespace of the
+// ReleaseStrategy, where the release PipelineRun runs. If the Pipeline is not found or the Get operation fails, an
+// error will be returned.
+func (l *loader) GetReleasePipeline(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*v1beta1.Pipeline, error) {
+	pipeline := &v1beta1.Pipeline{}
+	return pipeline, getObject(releaseStrategy.Spec.Pipeline, releaseStrategy.Namespace, cli, ctx, pipeline)
+}
+
 // GetReleaseStrategy returns the ReleaseStrategy referenced by the given ReleasePlanAdmission. If the ReleaseStrategy
 // is not found or the Get operation fails, an error will be returned.
 func (l *loader) GetReleaseStrategy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseStrategy, error) {
@@ -173,6 +345,71 @@ func (l *loader) GetReleaseStrategy(ctx context.Context, cli client.Client, rele
 	return releaseStrategy, getObject(releasePlanAdmission.Spec.ReleaseStrategy, releasePlanAdmission.Namespace, cli, ctx, releaseStrategy)
 }
 
+// GetReleaseStrategyByName returns the ReleaseStrategy with the given name and namespace. If the ReleaseStrategy is not
+// found or the Get operation fails, an error will be returned.
+func (l *loader) GetReleaseStrategyByName(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseStrategy, error) {
+	releaseStrategy := &v1alpha1.ReleaseStrategy{}
+	return releaseStrategy, getObject(name, namespace, cli, ctx, releaseStrategy)
+}
+
+// GetResourceQuotas returns all the ResourceQuotas in the given namespace. In the case the List operation fails,
+// an error will be returned.
+func (l *loader) GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) ([]corev1.ResourceQuota, error) {
+	resourceQuotas := &corev1.ResourceQuotaList{}
+	err := cli.List(ctx, resourceQuotas, client.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceQuotas.Items, nil
+}
+
+// GetRunningManagedPipelineRuns returns all the PipelineRuns created by the release service in the given namespace,
+// regardless of their type, that haven't finished yet. In the case the List operation fails, an error will be returned.
+func (l *loader) GetRunningManagedPipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error) {
+	pipelineRuns := &v1beta1.PipelineRunList{}
+	err := cli.List(ctx, pipelineRuns,
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			tekton.ManagedByLabel: tekton.ManagedByLabelValue,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var runningPipelineRuns []v1beta1.PipelineRun
+	for _, pipelineRun := range pipelineRuns.Items {
+		if !pipelineRun.IsDone() {
+			runningPipelineRuns = append(runningPipelineRuns, pipelineRun)
+		}
+	}
+
+	return runningPipelineRuns, nil
+}
+
+// GetRunningReleasePipelineRuns returns all the release PipelineRuns in the given namespace that haven't finished yet.
+// In the case the List operation fails, an error will be returned.
+func (l *loader) GetRunningReleasePipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error) {
+	pipelineRuns := &v1beta1.PipelineRunList{}
+	err := cli.List(ctx, pipelineRuns,
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			tekton.PipelinesTypeLabel: tekton.PipelineTypeRelease,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var runningPipelineRuns []v1beta1.PipelineRun
+	for _, pipelineRun := range pipelineRuns.Items {
+		if !pipelineRun.IsDone() {
+			runningPipelineRuns = append(runningPipelineRuns, pipelineRun)
+		}
+	}
+
+	return runningPipelineRuns, nil
+}
+
 // GetSnapshot returns the Snapshot referenced by the given Release. If the Snapshot is not found or the Get
 // operation fails, an error is returned.
 func (l *loader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
@@ -264,3 +501,38 @@ func (l *loader) GetSnapshotEnvironmentBindingResources(ctx context.Context, cli
 
 	return resources, nil
 }
+
+// getReleasePlanAdmissionSelection returns the policy used to pick a ReleasePlanAdmission when more than one matches
+// a ReleasePlan. The value is read from the RELEASE_PLAN_ADMISSION_SELECTION environment variable, which is set by the
+// --release-plan-admission-selection flag. Unknown or missing values default to ReleasePlanAdmissionSelectionError.
+func getReleasePlanAdmissionSelection() string {
+	if os.Getenv("RELEASE_PLAN_ADMISSION_SELECTION") == ReleasePlanAdmissionSelectionByName {
+		return ReleasePlanAdmissionSelectionByName
+	}
+
+	return ReleasePlanAdmissionSelectionError
+}
+
+// selectReleasePlanAdmission returns the ReleasePlanAdmission to use among the given candidates, or nil if there are
+// none. If there is more than one candidate and the selection policy is ReleasePlanAdmissionSelectionByName, the first
+// one sorted by namespace and name is returned. Otherwise, the selection is reported as ambiguous.
+func selectReleasePlanAdmission(candidates []v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanAdmission, bool) {
+	if len(candidates) == 0 {
+		return nil, false
+	}
+
+	if len(candidates) > 1 {
+		if getReleasePlanAdmissionSelection() != ReleasePlanAdmissionSelectionByName {
+			return nil, true
+		}
+
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].Namespace != candidates[j].Namespace {
+				return candidates[i].Namespace < candidates[j].Namespace
+			}
+			return candidates[i].Name < candidates[j].Name
+		})
+	}
+
+	return &candidates[0], false
+}
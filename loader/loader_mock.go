@@ -7,6 +7,7 @@ import (
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -25,13 +26,23 @@ type (
 const (
 	ApplicationContextKey                         contextKey = iota
 	ApplicationComponentsContextKey               contextKey = iota
+	CollectorsPipelineRunContextKey               contextKey = iota
 	EnterpriseContractPolicyContextKey            contextKey = iota
 	EnvironmentContextKey                         contextKey = iota
+	PersistentVolumeClaimContextKey               contextKey = iota
+	PreviousSuccessfulReleaseContextKey           contextKey = iota
 	ReleaseContextKey                             contextKey = iota
+	ReleasePipelineContextKey                     contextKey = iota
 	ReleasePipelineRunContextKey                  contextKey = iota
+	ReleasePipelineRunTaskRunsContextKey          contextKey = iota
 	ReleasePlanContextKey                         contextKey = iota
 	ReleasePlanAdmissionContextKey                contextKey = iota
+	ReleasePlanAdmissionByApplicationContextKey   contextKey = iota
 	ReleaseStrategyContextKey                     contextKey = iota
+	ReleaseStrategyByNameContextKey               contextKey = iota
+	ResourceQuotasContextKey                      contextKey = iota
+	RunningManagedPipelineRunsContextKey          contextKey = iota
+	RunningReleasePipelineRunsContextKey          contextKey = iota
 	SnapshotContextKey                            contextKey = iota
 	SnapshotEnvironmentBindingContextKey          contextKey = iota
 	SnapshotEnvironmentBindingResourcesContextKey contextKey = iota
@@ -107,6 +118,14 @@ func (l *mockLoader) GetApplicationComponents(ctx context.Context, cli client.Cl
 	return getMockedResourceAndErrorFromContext(ctx, ApplicationComponentsContextKey, []applicationapiv1alpha1.Component{})
 }
 
+// GetCollectorsPipelineRun returns the resource and error passed as values of the context.
+func (l *mockLoader) GetCollectorsPipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1beta1.PipelineRun, error) {
+	if ctx.Value(CollectorsPipelineRunContextKey) == nil {
+		return l.loader.GetCollectorsPipelineRun(ctx, cli, release)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, CollectorsPipelineRunContextKey, &v1beta1.PipelineRun{})
+}
+
 // GetEnterpriseContractPolicy returns the resource and error passed as values of the context.
 func (l *mockLoader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
 	if ctx.Value(EnterpriseContractPolicyContextKey) == nil {
@@ -123,6 +142,22 @@ func (l *mockLoader) GetEnvironment(ctx context.Context, cli client.Client, rele
 	return getMockedResourceAndErrorFromContext(ctx, EnvironmentContextKey, &applicationapiv1alpha1.Environment{})
 }
 
+// GetPersistentVolumeClaim returns the resource and error passed as values of the context.
+func (l *mockLoader) GetPersistentVolumeClaim(ctx context.Context, cli client.Client, name, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	if ctx.Value(PersistentVolumeClaimContextKey) == nil {
+		return l.loader.GetPersistentVolumeClaim(ctx, cli, name, namespace)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, PersistentVolumeClaimContextKey, &corev1.PersistentVolumeClaim{})
+}
+
+// GetPreviousSuccessfulRelease returns the resource and error passed as values of the context.
+func (l *mockLoader) GetPreviousSuccessfulRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.Release, error) {
+	if ctx.Value(PreviousSuccessfulReleaseContextKey) == nil {
+		return l.loader.GetPreviousSuccessfulRelease(ctx, cli, release)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, PreviousSuccessfulReleaseContextKey, &v1alpha1.Release{})
+}
+
 // GetRelease returns the resource and error passed as values of the context.
 func (l *mockLoader) GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error) {
 	if ctx.Value(ReleaseContextKey) == nil {
@@ -139,6 +174,14 @@ func (l *mockLoader) GetReleasePipelineRun(ctx context.Context, cli client.Clien
 	return getMockedResourceAndErrorFromContext(ctx, ReleasePipelineRunContextKey, &v1beta1.PipelineRun{})
 }
 
+// GetReleasePipelineRunTaskRuns returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePipelineRunTaskRuns(ctx context.Context, cli client.Client, pipelineRun *v1beta1.PipelineRun) ([]v1beta1.TaskRun, error) {
+	if ctx.Value(ReleasePipelineRunTaskRunsContextKey) == nil {
+		return l.loader.GetReleasePipelineRunTaskRuns(ctx, cli, pipelineRun)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, ReleasePipelineRunTaskRunsContextKey, []v1beta1.TaskRun{})
+}
+
 // GetReleasePlan returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error) {
 	if ctx.Value(ReleasePlanContextKey) == nil {
@@ -147,6 +190,22 @@ func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, rele
 	return getMockedResourceAndErrorFromContext(ctx, ReleasePlanContextKey, &v1alpha1.ReleasePlan{})
 }
 
+// GetReleasePlanAdmissionByApplication returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePlanAdmissionByApplication(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error) {
+	if ctx.Value(ReleasePlanAdmissionByApplicationContextKey) == nil {
+		return l.loader.GetReleasePlanAdmissionByApplication(ctx, cli, releasePlan)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, ReleasePlanAdmissionByApplicationContextKey, &v1alpha1.ReleasePlanAdmission{})
+}
+
+// GetReleasePipeline returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePipeline(ctx context.Context, cli client.Client, releaseStrategy *v1alpha1.ReleaseStrategy) (*v1beta1.Pipeline, error) {
+	if ctx.Value(ReleasePipelineContextKey) == nil {
+		return l.loader.GetReleasePipeline(ctx, cli, releaseStrategy)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, ReleasePipelineContextKey, &v1beta1.Pipeline{})
+}
+
 // GetReleaseStrategy returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleaseStrategy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseStrategy, error) {
 	if ctx.Value(ReleaseStrategyContextKey) == nil {
@@ -155,6 +214,38 @@ func (l *mockLoader) GetReleaseStrategy(ctx context.Context, cli client.Client,
 	return getMockedResourceAndErrorFromContext(ctx, ReleaseStrategyContextKey, &v1alpha1.ReleaseStrategy{})
 }
 
+// GetReleaseStrategyByName returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleaseStrategyByName(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseStrategy, error) {
+	if ctx.Value(ReleaseStrategyByNameContextKey) == nil {
+		return l.loader.GetReleaseStrategyByName(ctx, cli, name, namespace)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, ReleaseStrategyByNameContextKey, &v1alpha1.ReleaseStrategy{})
+}
+
+// GetResourceQuotas returns the resource and error passed as values of the context.
+func (l *mockLoader) GetResourceQuotas(ctx context.Context, cli client.Client, namespace string) ([]corev1.ResourceQuota, error) {
+	if ctx.Value(ResourceQuotasContextKey) == nil {
+		return l.loader.GetResourceQuotas(ctx, cli, namespace)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, ResourceQuotasContextKey, []corev1.ResourceQuota{})
+}
+
+// GetRunningManagedPipelineRuns returns the resource and error passed as values of the context.
+func (l *mockLoader) GetRunningManagedPipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error) {
+	if ctx.Value(RunningManagedPipelineRunsContextKey) == nil {
+		return l.loader.GetRunningManagedPipelineRuns(ctx, cli, namespace)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, RunningManagedPipelineRunsContextKey, []v1beta1.PipelineRun{})
+}
+
+// GetRunningReleasePipelineRuns returns the resource and error passed as values of the context.
+func (l *mockLoader) GetRunningReleasePipelineRuns(ctx context.Context, cli client.Client, namespace string) ([]v1beta1.PipelineRun, error) {
+	if ctx.Value(RunningReleasePipelineRunsContextKey) == nil {
+		return l.loader.GetRunningReleasePipelineRuns(ctx, cli, namespace)
+	}
+	return getMockedResourceAndErrorFromContext(ctx, RunningReleasePipelineRunsContextKey, []v1beta1.PipelineRun{})
+}
+
 // GetSnapshot returns the resource and error passed as values of the context.
 func (l *mockLoader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
 	if ctx.Value(SnapshotContextKey) == nil {
@@ -9,6 +9,7 @@ import (
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -138,6 +139,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetCollectorsPipelineRun", func() {
+		It("returns the resource and error from the context", func() {
+			pipelineRun := &v1beta1.PipelineRun{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: CollectorsPipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+			resource, err := loader.GetCollectorsPipelineRun(mockContext, nil, nil)
+			Expect(resource).To(Equal(pipelineRun))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("When calling GetEnterpriseContractPolicy", func() {
 		It("returns the resource and error from the context", func() {
 			enterpriseContractPolicy := &v1alpha12.EnterpriseContractPolicy{}
@@ -168,6 +184,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetPersistentVolumeClaim", func() {
+		It("returns the resource and error from the context", func() {
+			persistentVolumeClaim := &corev1.PersistentVolumeClaim{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: PersistentVolumeClaimContextKey,
+					Resource:   persistentVolumeClaim,
+				},
+			})
+			resource, err := loader.GetPersistentVolumeClaim(mockContext, nil, "", "")
+			Expect(resource).To(Equal(persistentVolumeClaim))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("When calling GetPreviousSuccessfulRelease", func() {
+		It("returns the resource and error from the context", func() {
+			release := &v1alpha1.Release{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: PreviousSuccessfulReleaseContextKey,
+					Resource:   release,
+				},
+			})
+			resource, err := loader.GetPreviousSuccessfulRelease(mockContext, nil, nil)
+			Expect(resource).To(Equal(release))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("When calling GetRelease", func() {
 		It("returns the resource and error from the context", func() {
 			release := &v1alpha1.Release{}
@@ -198,6 +244,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetReleasePipelineRunTaskRuns", func() {
+		It("returns the resource and error from the context", func() {
+			var taskRuns []v1beta1.TaskRun
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: ReleasePipelineRunTaskRunsContextKey,
+					Resource:   taskRuns,
+				},
+			})
+			resource, err := loader.GetReleasePipelineRunTaskRuns(mockContext, nil, nil)
+			Expect(resource).To(Equal(taskRuns))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("When calling GetReleasePlan", func() {
 		It("returns the resource and error from the context", func() {
 			releasePlan := &v1alpha1.ReleasePlan{}
@@ -213,6 +274,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetReleasePlanAdmissionByApplication", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlanAdmission := &v1alpha1.ReleasePlanAdmission{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: ReleasePlanAdmissionByApplicationContextKey,
+					Resource:   releasePlanAdmission,
+				},
+			})
+			resource, err := loader.GetReleasePlanAdmissionByApplication(mockContext, nil, nil)
+			Expect(resource).To(Equal(releasePlanAdmission))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("When calling GetReleasePipeline", func() {
+		It("returns the resource and error from the context", func() {
+			pipeline := &v1beta1.Pipeline{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: ReleasePipelineContextKey,
+					Resource:   pipeline,
+				},
+			})
+			resource, err := loader.GetReleasePipeline(mockContext, nil, nil)
+			Expect(resource).To(Equal(pipeline))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("When calling GetReleaseStrategy", func() {
 		It("returns the resource and error from the context", func() {
 			releaseStrategy := &v1alpha1.ReleaseStrategy{}
@@ -228,6 +319,66 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetReleaseStrategyByName", func() {
+		It("returns the resource and error from the context", func() {
+			releaseStrategy := &v1alpha1.ReleaseStrategy{}
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: ReleaseStrategyByNameContextKey,
+					Resource:   releaseStrategy,
+				},
+			})
+			resource, err := loader.GetReleaseStrategyByName(mockContext, nil, "", "")
+			Expect(resource).To(Equal(releaseStrategy))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("When calling GetResourceQuotas", func() {
+		It("returns the resource and error from the context", func() {
+			var resourceQuotas []corev1.ResourceQuota
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: ResourceQuotasContextKey,
+					Resource:   resourceQuotas,
+				},
+			})
+			resource, err := loader.GetResourceQuotas(mockContext, nil, "")
+			Expect(resource).To(Equal(resourceQuotas))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("When calling GetRunningManagedPipelineRuns", func() {
+		It("returns the resource and error from the context", func() {
+			var pipelineRuns []v1beta1.PipelineRun
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: RunningManagedPipelineRunsContextKey,
+					Resource:   pipelineRuns,
+				},
+			})
+			resource, err := loader.GetRunningManagedPipelineRuns(mockContext, nil, "")
+			Expect(resource).To(Equal(pipelineRuns))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("When calling GetRunningReleasePipelineRuns", func() {
+		It("returns the resource and error from the context", func() {
+			var pipelineRuns []v1beta1.PipelineRun
+			mockContext := GetMockedContext(ctx, []MockData{
+				{
+					ContextKey: RunningReleasePipelineRunsContextKey,
+					Resource:   pipelineRuns,
+				},
+			})
+			resource, err := loader.GetRunningReleasePipelineRuns(mockContext, nil, "")
+			Expect(resource).To(Equal(pipelineRuns))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("When calling GetSnapshot", func() {
 		It("returns the resource and error from the context", func() {
 			snapshot := &applicationapiv1alpha1.Snapshot{}
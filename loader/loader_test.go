@@ -2,6 +2,7 @@ package loader
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
@@ -12,7 +13,9 @@ import (
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/redhat-appstudio/release-service/tekton"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -73,7 +76,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 
 		It("fails to return an active release plan admission if the target does not match", func() {
 			modifiedReleasePlan := releasePlan.DeepCopy()
-			modifiedReleasePlan.Spec.Target = "non-existent-target"
+			modifiedReleasePlan.Spec.Target = "kube-public"
 
 			returnedObject, err := loader.GetActiveReleasePlanAdmission(ctx, k8sClient, modifiedReleasePlan)
 			Expect(err).To(HaveOccurred())
@@ -81,6 +84,16 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(returnedObject).To(BeNil())
 		})
 
+		It("fails to return an active release plan admission if the target namespace does not exist", func() {
+			modifiedReleasePlan := releasePlan.DeepCopy()
+			modifiedReleasePlan.Spec.Target = "non-existent-target"
+
+			returnedObject, err := loader.GetActiveReleasePlanAdmission(ctx, k8sClient, modifiedReleasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("target namespace (non-existent-target) not found"))
+			Expect(returnedObject).To(BeNil())
+		})
+
 		It("fails to return an active release plan admission if multiple matches are found", func() {
 			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
 			newReleasePlanAdmission.Name = "new-release-plan-admission"
@@ -95,6 +108,23 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(k8sClient.Delete(ctx, newReleasePlanAdmission)).To(Succeed())
 		})
 
+		It("returns the first release plan admission by name if multiple matches are found and they are picked by name", func() {
+			os.Setenv("RELEASE_PLAN_ADMISSION_SELECTION", ReleasePlanAdmissionSelectionByName)
+			defer os.Unsetenv("RELEASE_PLAN_ADMISSION_SELECTION")
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Name = "a-release-plan-admission"
+			newReleasePlanAdmission.ResourceVersion = ""
+			Expect(k8sClient.Create(ctx, newReleasePlanAdmission)).To(Succeed())
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetActiveReleasePlanAdmission(ctx, k8sClient, releasePlan)
+				return err == nil && returnedObject.Name == newReleasePlanAdmission.Name
+			}).Should(BeTrue())
+
+			Expect(k8sClient.Delete(ctx, newReleasePlanAdmission)).To(Succeed())
+		})
+
 		It("fails to return an active release plan admission if the auto release label is set to false", func() {
 			disabledReleasePlanAdmission := releasePlanAdmission.DeepCopy()
 			disabledReleasePlanAdmission.Labels[v1alpha1.AutoReleaseLabel] = "false"
@@ -128,6 +158,16 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(errors.IsNotFound(err)).To(BeTrue())
 			Expect(returnedObject).To(BeNil())
 		})
+
+		It("searches for the release plan admission in the override target if the release sets one", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Spec.OverrideTarget = "kube-public"
+
+			returnedObject, err := loader.GetActiveReleasePlanAdmissionFromRelease(ctx, k8sClient, modifiedRelease)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no ReleasePlanAdmission found in the target (kube-public)"))
+			Expect(returnedObject).To(BeNil())
+		})
 	})
 
 	Context("When calling GetApplication", func() {
@@ -148,6 +188,32 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetCollectorsPipelineRun", func() {
+		It("returns the collectors PipelineRun if the labels match with the release data", func() {
+			collectorsPipelineRun := pipelineRun.DeepCopy()
+			collectorsPipelineRun.Name = "collectors-pipeline-run"
+			collectorsPipelineRun.ResourceVersion = ""
+			collectorsPipelineRun.Labels[tekton.PipelinesTypeLabel] = tekton.PipelineTypeCollectors
+			Expect(k8sClient.Create(ctx, collectorsPipelineRun)).To(Succeed())
+			defer k8sClient.Delete(ctx, collectorsPipelineRun)
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetCollectorsPipelineRun(ctx, k8sClient, release)
+				return err == nil && returnedObject != nil && returnedObject.Name == collectorsPipelineRun.Name
+			}).Should(BeTrue())
+
+			returnedObject, err := loader.GetReleasePipelineRun(ctx, k8sClient, release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Name).To(Equal(pipelineRun.Name))
+		})
+
+		It("fails to return a PipelineRun if there is no collectors PipelineRun for the release", func() {
+			returnedObject, err := loader.GetCollectorsPipelineRun(ctx, k8sClient, release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).To(BeNil())
+		})
+	})
+
 	Context("When calling GetEnterpriseContractPolicy", func() {
 		It("returns the requested enterprise contract policy", func() {
 			returnedObject, err := loader.GetEnterpriseContractPolicy(ctx, k8sClient, releaseStrategy)
@@ -166,6 +232,70 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetPersistentVolumeClaim", func() {
+		It("returns the requested PersistentVolumeClaim", func() {
+			persistentVolumeClaim := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-pvc",
+					Namespace: "default",
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, persistentVolumeClaim)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, persistentVolumeClaim)).To(Succeed())
+			}()
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetPersistentVolumeClaim(ctx, k8sClient, persistentVolumeClaim.Name,
+					persistentVolumeClaim.Namespace)
+				return err == nil && returnedObject.Name == persistentVolumeClaim.Name
+			}).Should(BeTrue())
+		})
+
+		It("fails to return a PersistentVolumeClaim that doesn't exist", func() {
+			_, err := loader.GetPersistentVolumeClaim(ctx, k8sClient, "non-existing-pvc", "default")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When calling GetPreviousSuccessfulRelease", func() {
+		It("returns nil if there are no other successful releases", func() {
+			returnedObject, err := loader.GetPreviousSuccessfulRelease(ctx, k8sClient, release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).To(BeNil())
+		})
+
+		It("returns the latest successful release using the same ReleasePlan", func() {
+			previousRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "previous-release",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    snapshot.Name,
+					ReleasePlan: releasePlan.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, previousRelease)).To(Succeed())
+			defer k8sClient.Delete(ctx, previousRelease)
+
+			previousRelease.MarkRunning()
+			previousRelease.MarkSucceeded()
+			Expect(k8sClient.Status().Update(ctx, previousRelease)).To(Succeed())
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetPreviousSuccessfulRelease(ctx, k8sClient, release)
+				return err == nil && returnedObject != nil && returnedObject.Name == previousRelease.Name
+			}).Should(BeTrue())
+		})
+	})
+
 	Context("When calling GetRelease", func() {
 		It("returns the requested release", func() {
 			returnedObject, err := loader.GetRelease(ctx, k8sClient, release.Name, release.Namespace)
@@ -191,6 +321,48 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(returnedObject).To(BeNil())
 		})
+
+		It("returns the PipelineRun referenced in the release status if none is labeled for the release", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Name = "adopting-release"
+			modifiedRelease.Status.ReleasePipelineRun = pipelineRun.Namespace + "/" + pipelineRun.Name
+
+			returnedObject, err := loader.GetReleasePipelineRun(ctx, k8sClient, modifiedRelease)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(BeNil())
+			Expect(returnedObject.Name).To(Equal(pipelineRun.Name))
+		})
+
+		It("fails to return a PipelineRun if the one referenced in the release status doesn't exist", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Name = "adopting-release"
+			modifiedRelease.Status.ReleasePipelineRun = pipelineRun.Namespace + "/non-existing-pipelinerun"
+
+			returnedObject, err := loader.GetReleasePipelineRun(ctx, k8sClient, modifiedRelease)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).To(BeNil())
+		})
+	})
+
+	Context("When calling GetReleasePipelineRunTaskRuns", func() {
+		It("returns the TaskRuns of the PipelineRun", func() {
+			taskRun := &v1beta1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "taskrun",
+					Namespace: pipelineRun.Namespace,
+					Labels: map[string]string{
+						"tekton.dev/pipelineRun": pipelineRun.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+			defer k8sClient.Delete(ctx, taskRun)
+
+			Eventually(func() bool {
+				returnedObjects, err := loader.GetReleasePipelineRunTaskRuns(ctx, k8sClient, pipelineRun)
+				return err == nil && len(returnedObjects) == 1 && returnedObjects[0].Name == taskRun.Name
+			}).Should(BeTrue())
+		})
 	})
 
 	Context("When calling GetReleasePlan", func() {
@@ -202,6 +374,103 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetReleasePlanAdmissionByApplication", func() {
+		It("returns the release plan admission for the application regardless of the target", func() {
+			modifiedReleasePlan := releasePlan.DeepCopy()
+			modifiedReleasePlan.Spec.Target = "non-existent-target"
+
+			returnedObject, err := loader.GetReleasePlanAdmissionByApplication(ctx, k8sClient, modifiedReleasePlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Name).To(Equal(releasePlanAdmission.Name))
+		})
+
+		It("fails to return a release plan admission if the application does not match", func() {
+			modifiedReleasePlan := releasePlan.DeepCopy()
+			modifiedReleasePlan.Spec.Application = "non-existent-application"
+
+			returnedObject, err := loader.GetReleasePlanAdmissionByApplication(ctx, k8sClient, modifiedReleasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no ReleasePlanAdmission found for application"))
+			Expect(returnedObject).To(BeNil())
+		})
+	})
+
+	Context("When calling selectReleasePlanAdmission", func() {
+		var candidates []v1alpha1.ReleasePlanAdmission
+
+		BeforeEach(func() {
+			candidates = []v1alpha1.ReleasePlanAdmission{
+				{ObjectMeta: metav1.ObjectMeta{Name: "rpa-b", Namespace: "managed"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "rpa-a", Namespace: "managed"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "rpa-c", Namespace: "another-managed"}},
+			}
+		})
+
+		It("returns nil if there are no candidates", func() {
+			returnedObject, ambiguous := selectReleasePlanAdmission(nil)
+			Expect(returnedObject).To(BeNil())
+			Expect(ambiguous).To(BeFalse())
+		})
+
+		It("returns the only candidate", func() {
+			returnedObject, ambiguous := selectReleasePlanAdmission(candidates[:1])
+			Expect(returnedObject.Name).To(Equal("rpa-b"))
+			Expect(ambiguous).To(BeFalse())
+		})
+
+		It("reports the selection as ambiguous if there are multiple candidates", func() {
+			returnedObject, ambiguous := selectReleasePlanAdmission(candidates)
+			Expect(returnedObject).To(BeNil())
+			Expect(ambiguous).To(BeTrue())
+		})
+
+		It("returns the first candidate sorted by namespace and name if they are picked by name", func() {
+			os.Setenv("RELEASE_PLAN_ADMISSION_SELECTION", ReleasePlanAdmissionSelectionByName)
+			defer os.Unsetenv("RELEASE_PLAN_ADMISSION_SELECTION")
+
+			returnedObject, ambiguous := selectReleasePlanAdmission(candidates)
+			Expect(returnedObject.Name).To(Equal("rpa-c"))
+			Expect(ambiguous).To(BeFalse())
+
+			returnedObject, _ = selectReleasePlanAdmission([]v1alpha1.ReleasePlanAdmission{
+				{ObjectMeta: metav1.ObjectMeta{Name: "rpa-b", Namespace: "managed"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "rpa-a", Namespace: "managed"}},
+			})
+			Expect(returnedObject.Name).To(Equal("rpa-a"))
+		})
+	})
+
+	Context("When calling GetReleasePipeline", func() {
+		It("returns the Pipeline referenced by the release strategy", func() {
+			pipeline := &v1beta1.Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      releaseStrategy.Spec.Pipeline,
+					Namespace: releaseStrategy.Namespace,
+				},
+				Spec: v1beta1.PipelineSpec{
+					Tasks: []v1beta1.PipelineTask{
+						{Name: "push", TaskRef: &v1beta1.TaskRef{Name: "push"}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipeline)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, pipeline)).To(Succeed()) }()
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleasePipeline(ctx, k8sClient, releaseStrategy)
+				return err == nil && returnedObject.Name == pipeline.Name
+			}).Should(BeTrue())
+		})
+
+		It("fails to return a Pipeline that doesn't exist", func() {
+			modifiedReleaseStrategy := releaseStrategy.DeepCopy()
+			modifiedReleaseStrategy.Spec.Pipeline = "non-existing-pipeline"
+
+			_, err := loader.GetReleasePipeline(ctx, k8sClient, modifiedReleaseStrategy)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	Context("When calling GetReleaseStrategy", func() {
 		It("returns the requested release strategy", func() {
 			returnedObject, err := loader.GetReleaseStrategy(ctx, k8sClient, releasePlanAdmission)
@@ -211,6 +480,72 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	Context("When calling GetReleaseStrategyByName", func() {
+		It("returns the requested release strategy", func() {
+			returnedObject, err := loader.GetReleaseStrategyByName(ctx, k8sClient, releaseStrategy.Name, releaseStrategy.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject).NotTo(Equal(&v1alpha1.ReleaseStrategy{}))
+			Expect(returnedObject.Name).To(Equal(releaseStrategy.Name))
+		})
+	})
+
+	Context("When calling GetResourceQuotas", func() {
+		It("returns the ResourceQuotas in the namespace", func() {
+			resourceQuota := &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "resource-quota",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resourceQuota)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, resourceQuota)).To(Succeed())
+			}()
+
+			Eventually(func() []corev1.ResourceQuota {
+				returnedObjects, err := loader.GetResourceQuotas(ctx, k8sClient, resourceQuota.Namespace)
+				Expect(err).NotTo(HaveOccurred())
+				return returnedObjects
+			}).Should(HaveLen(1))
+		})
+
+		It("returns no ResourceQuotas if there are none in the namespace", func() {
+			returnedObjects, err := loader.GetResourceQuotas(ctx, k8sClient, "non-existing-namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObjects).To(BeEmpty())
+		})
+	})
+
+	Context("When calling GetRunningManagedPipelineRuns", func() {
+		It("returns the PipelineRuns managed by the release service that haven't finished yet", func() {
+			returnedObjects, err := loader.GetRunningManagedPipelineRuns(ctx, k8sClient, pipelineRun.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObjects).To(HaveLen(1))
+			Expect(returnedObjects[0].Name).To(Equal(pipelineRun.Name))
+		})
+
+		It("returns no PipelineRuns if there are no managed PipelineRuns in the namespace", func() {
+			returnedObjects, err := loader.GetRunningManagedPipelineRuns(ctx, k8sClient, "non-existing-namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObjects).To(BeEmpty())
+		})
+	})
+
+	Context("When calling GetRunningReleasePipelineRuns", func() {
+		It("returns the release PipelineRuns that haven't finished yet", func() {
+			returnedObjects, err := loader.GetRunningReleasePipelineRuns(ctx, k8sClient, pipelineRun.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObjects).To(HaveLen(1))
+			Expect(returnedObjects[0].Name).To(Equal(pipelineRun.Name))
+		})
+
+		It("returns no PipelineRuns if there are no release PipelineRuns in the namespace", func() {
+			returnedObjects, err := loader.GetRunningReleasePipelineRuns(ctx, k8sClient, "non-existing-namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObjects).To(BeEmpty())
+		})
+	})
+
 	Context("When calling GetSnapshot", func() {
 		It("returns the requested snapshot", func() {
 			returnedObject, err := loader.GetSnapshot(ctx, k8sClient, release)
@@ -411,6 +746,8 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		pipelineRun = &v1beta1.PipelineRun{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: map[string]string{
+					tekton.ManagedByLabel:        tekton.ManagedByLabelValue,
+					tekton.PipelinesTypeLabel:    tekton.PipelineTypeRelease,
 					tekton.ReleaseNameLabel:      release.Name,
 					tekton.ReleaseNamespaceLabel: release.Namespace,
 				},
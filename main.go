@@ -18,8 +18,10 @@ package main
 
 import (
 	"flag"
-	"go.uber.org/zap/zapcore"
 	"os"
+	"strconv"
+
+	"go.uber.org/zap/zapcore"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -28,17 +30,24 @@ import (
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 
 	appstudiov1alpha1 "github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"github.com/redhat-appstudio/release-service/cache"
 	"github.com/redhat-appstudio/release-service/controllers"
+	"github.com/redhat-appstudio/release-service/loader"
+	"github.com/redhat-appstudio/release-service/query"
+	"github.com/redhat-appstudio/release-service/tekton"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -61,11 +70,52 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var allowTargetOverride bool
+	var enableTargetFallback bool
+	var lowercaseParamNames bool
+	var defaultServiceAccount string
+	var tektonAPIVersion string
+	var deduplicatePipelineRuns bool
+	var watchLabelSelector string
+	var queryAPIAddr string
+	var maxConcurrentReleases int
+	var allowedBundleRegistries string
+	var releasePlanAdmissionSelection string
+	var watchNamespace string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&allowTargetOverride, "allow-target-override", false,
+		"Allow Releases to override the target namespace set in their ReleasePlan.")
+	flag.BoolVar(&enableTargetFallback, "enable-target-fallback", false,
+		"Match ReleasePlanAdmissions by application alone when none matches the ReleasePlan target.")
+	flag.BoolVar(&lowercaseParamNames, "lowercase-param-names", false,
+		"Lowercase the names of the ReleaseStrategy params when normalizing them at admission.")
+	flag.StringVar(&defaultServiceAccount, "default-service-account", "",
+		"The service account used by release PipelineRuns whose ReleaseStrategy doesn't set one.")
+	flag.StringVar(&tektonAPIVersion, "tekton-api-version", tekton.APIVersionV1beta1,
+		"The Tekton API version used to create release PipelineRuns (v1beta1 or v1).")
+	flag.BoolVar(&deduplicatePipelineRuns, "deduplicate-pipelineruns", false,
+		"Let Releases adopt a running release PipelineRun identical to the one they would create instead of creating a duplicate.")
+	flag.StringVar(&watchLabelSelector, "watch-label-selector", "",
+		"The label selector Releases have to match to be reconciled by this controller. All Releases are reconciled if it's not set.")
+	flag.StringVar(&queryAPIAddr, "query-api-bind-address", "",
+		"The address the read-only Release query API binds to. The API is disabled if no address is set. "+
+			"Requests have to be authenticated with the token set in the RELEASE_QUERY_API_TOKEN environment variable.")
+	flag.IntVar(&maxConcurrentReleases, "max-concurrent-releases", 0,
+		"The maximum number of release PipelineRuns allowed to run at the same time in a target namespace. "+
+			"Releases over the limit wait for a slot. The RELEASE_PIPELINE_MAX_CONCURRENT environment variable is used if it's not set.")
+	flag.StringVar(&allowedBundleRegistries, "allowed-bundle-registries", "",
+		"The comma-separated list of registries release PipelineRuns are allowed to pull Tekton bundles from. "+
+			"All registries are allowed if it's not set.")
+	flag.StringVar(&watchNamespace, "watch-namespace", os.Getenv("WATCH_NAMESPACE"),
+		"The comma-separated list of namespaces watched by the controller. The targets of the watched ReleasePlans have "+
+			"to be included. The whole cluster is watched if it's not set. Defaults to the WATCH_NAMESPACE environment variable.")
+	flag.StringVar(&releasePlanAdmissionSelection, "release-plan-admission-selection", loader.ReleasePlanAdmissionSelectionError,
+		"How to pick the ReleasePlanAdmission of a ReleasePlan matched by more than one. "+
+			"Either fail as the configuration is ambiguous (error) or pick the first one sorted by namespace and name (name).")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
@@ -75,14 +125,36 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	switch tektonAPIVersion {
+	case tekton.APIVersionV1:
+		utilruntime.Must(tektonv1.AddToScheme(scheme))
+	case tekton.APIVersionV1beta1:
+	default:
+		setupLog.Error(nil, "unsupported Tekton API version", "tekton-api-version", tektonAPIVersion)
+		os.Exit(1)
+	}
+
+	switch releasePlanAdmissionSelection {
+	case loader.ReleasePlanAdmissionSelectionError, loader.ReleasePlanAdmissionSelectionByName:
+	default:
+		setupLog.Error(nil, "unsupported ReleasePlanAdmission selection policy",
+			"release-plan-admission-selection", releasePlanAdmissionSelection)
+		os.Exit(1)
+	}
+
+	options := ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "f3d4c01a.redhat.com",
-	})
+		// The resultsOutput resources are only read to check who owns them, so there's no need to watch them
+		ClientDisableCacheFor: []client.Object{&corev1.ConfigMap{}, &corev1.Secret{}},
+	}
+	cache.SetupWatchNamespaces(&options, watchNamespace)
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -106,6 +178,83 @@ func main() {
 		}
 	}
 
+	// Expose the default-service-account flag to the controllers through the DEFAULT_RELEASE_SERVICE_ACCOUNT environment
+	// variable
+	err = os.Setenv("DEFAULT_RELEASE_SERVICE_ACCOUNT", defaultServiceAccount)
+	if err != nil {
+		setupLog.Error(err, "unable to setup DEFAULT_RELEASE_SERVICE_ACCOUNT environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the allow-target-override flag to the controllers through the ALLOW_TARGET_OVERRIDE environment variable
+	err = os.Setenv("ALLOW_TARGET_OVERRIDE", strconv.FormatBool(allowTargetOverride))
+	if err != nil {
+		setupLog.Error(err, "unable to setup ALLOW_TARGET_OVERRIDE environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the enable-target-fallback flag to the controllers through the ENABLE_TARGET_FALLBACK environment variable
+	err = os.Setenv("ENABLE_TARGET_FALLBACK", strconv.FormatBool(enableTargetFallback))
+	if err != nil {
+		setupLog.Error(err, "unable to setup ENABLE_TARGET_FALLBACK environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the release-plan-admission-selection flag to the controllers through the RELEASE_PLAN_ADMISSION_SELECTION
+	// environment variable
+	err = os.Setenv("RELEASE_PLAN_ADMISSION_SELECTION", releasePlanAdmissionSelection)
+	if err != nil {
+		setupLog.Error(err, "unable to setup RELEASE_PLAN_ADMISSION_SELECTION environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the lowercase-param-names flag to the webhooks through the LOWERCASE_PARAM_NAMES environment variable
+	err = os.Setenv("LOWERCASE_PARAM_NAMES", strconv.FormatBool(lowercaseParamNames))
+	if err != nil {
+		setupLog.Error(err, "unable to setup LOWERCASE_PARAM_NAMES environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the deduplicate-pipelineruns flag to the controllers through the DEDUPLICATE_PIPELINE_RUNS environment
+	// variable
+	err = os.Setenv("DEDUPLICATE_PIPELINE_RUNS", strconv.FormatBool(deduplicatePipelineRuns))
+	if err != nil {
+		setupLog.Error(err, "unable to setup DEDUPLICATE_PIPELINE_RUNS environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the watch-label-selector flag to the controllers through the WATCH_LABEL_SELECTOR environment variable
+	err = os.Setenv("WATCH_LABEL_SELECTOR", watchLabelSelector)
+	if err != nil {
+		setupLog.Error(err, "unable to setup WATCH_LABEL_SELECTOR environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the allowed-bundle-registries flag to the controllers through the ALLOWED_BUNDLE_REGISTRIES environment
+	// variable
+	err = os.Setenv("ALLOWED_BUNDLE_REGISTRIES", allowedBundleRegistries)
+	if err != nil {
+		setupLog.Error(err, "unable to setup ALLOWED_BUNDLE_REGISTRIES environment variable")
+		os.Exit(1)
+	}
+
+	// Expose the max-concurrent-releases flag to the controllers through the RELEASE_PIPELINE_MAX_CONCURRENT environment
+	// variable, keeping the value from the environment if the flag is not set
+	if maxConcurrentReleases > 0 {
+		err = os.Setenv("RELEASE_PIPELINE_MAX_CONCURRENT", strconv.Itoa(maxConcurrentReleases))
+		if err != nil {
+			setupLog.Error(err, "unable to setup RELEASE_PIPELINE_MAX_CONCURRENT environment variable")
+			os.Exit(1)
+		}
+	}
+
+	// Expose the tekton-api-version flag to the controllers through the TEKTON_API_VERSION environment variable
+	err = os.Setenv("TEKTON_API_VERSION", tektonAPIVersion)
+	if err != nil {
+		setupLog.Error(err, "unable to setup TEKTON_API_VERSION environment variable")
+		os.Exit(1)
+	}
+
 	err = controllers.SetupControllers(mgr)
 	if err != nil {
 		setupLog.Error(err, "unable to setup controllers")
@@ -129,6 +278,24 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "ReleasePlan")
 			os.Exit(1)
 		}
+
+		if err = (&appstudiov1alpha1.ReleaseStrategy{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ReleaseStrategy")
+			os.Exit(1)
+		}
+	}
+
+	if queryAPIAddr != "" {
+		token := os.Getenv("RELEASE_QUERY_API_TOKEN")
+		if token == "" {
+			setupLog.Error(nil, "the query API requires the RELEASE_QUERY_API_TOKEN environment variable to be set")
+			os.Exit(1)
+		}
+
+		if err := mgr.Add(query.NewServer(mgr.GetClient(), queryAPIAddr, token)); err != nil {
+			setupLog.Error(err, "unable to set up the query API")
+			os.Exit(1)
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
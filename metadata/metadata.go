@@ -17,10 +17,16 @@ limitations under the License.
 package metadata
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"regexp"
 	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// invalidLabelValueChars matches the characters that are not allowed in label values.
+var invalidLabelValueChars = regexp.MustCompile(`[^-A-Za-z0-9_.]`)
+
 // AddAnnotations copies the map into the resource's Annotations map.
 // When the destination map is nil, then the map will be created.
 // The unexported function addEntries is called with args passed.
@@ -53,6 +59,19 @@ func GetLabelsWithPrefix(obj v1.Object, prefix string) map[string]string {
 	return filterByPrefix(obj.GetLabels(), prefix)
 }
 
+// SanitizeLabelValue converts the given string into a valid label value.
+// Characters not allowed in label values are replaced with dashes, the value is truncated to the maximum
+// label value length and any non-alphanumeric characters left at the beginning or the end are removed.
+func SanitizeLabelValue(value string) string {
+	value = invalidLabelValueChars.ReplaceAllString(value, "-")
+	value = strings.TrimLeft(value, "-_.")
+	if len(value) > validation.LabelValueMaxLength {
+		value = value[:validation.LabelValueMaxLength]
+	}
+
+	return strings.TrimRight(value, "-_.")
+}
+
 // addEntries copies key/value pairs in the source map adding them into the destination map.
 // The unexported function safeCopy is used to copy, and avoids clobbering existing keys in the destination map.
 func addEntries(source, destination map[string]string) {
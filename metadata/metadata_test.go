@@ -17,12 +17,14 @@ limitations under the License.
 package metadata
 
 import (
+	"strings"
+	"testing"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	"testing"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestMetadata(t *testing.T) {
@@ -208,4 +210,30 @@ var _ = Describe("Metadata", func() {
 			})
 		})
 	})
+
+	Context("SanitizeLabelValue function", func() {
+		When("called with a valid label value", func() {
+			It("should return the same value", func() {
+				Expect(SanitizeLabelValue("sha256.abc_123-def")).To(Equal("sha256.abc_123-def"))
+			})
+		})
+		When("called with a value containing invalid characters", func() {
+			It("should replace them with dashes", func() {
+				Expect(SanitizeLabelValue("sha256:abc/def")).To(Equal("sha256-abc-def"))
+			})
+		})
+		When("called with a value starting or ending with non-alphanumeric characters", func() {
+			It("should remove them", func() {
+				Expect(SanitizeLabelValue("-_.value.:")).To(Equal("value"))
+			})
+		})
+		When("called with a value longer than the maximum label value length", func() {
+			It("should truncate it", func() {
+				Expect(SanitizeLabelValue(strings.Repeat("a", 100))).To(Equal(strings.Repeat("a", 63)))
+			})
+			It("should not leave non-alphanumeric characters at the end after truncating", func() {
+				Expect(SanitizeLabelValue(strings.Repeat("a", 62) + ":b")).To(Equal(strings.Repeat("a", 62)))
+			})
+		})
+	})
 })
@@ -17,13 +17,32 @@ limitations under the License.
 package metrics
 
 import (
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+const (
+	// applicationsAllowlistEnvVar is the environment variable holding the comma-separated list of applications that
+	// can be used as values of the application label
+	applicationsAllowlistEnvVar = "RELEASE_METRICS_APPLICATIONS"
+
+	// otherApplicationLabelValue is the value used for the application label when the application is not in the
+	// allowlist
+	otherApplicationLabelValue = "other"
+
+	// otherTargetLabelValue is the value used for the target label when the target namespace is not in the allowlist
+	otherTargetLabelValue = "other"
+
+	// targetNamespacesAllowlistEnvVar is the environment variable holding the comma-separated list of target
+	// namespaces that can be used as values of the target label
+	targetNamespacesAllowlistEnvVar = "RELEASE_METRICS_TARGET_NAMESPACES"
+)
+
 var (
 	ReleaseAttemptConcurrentTotal = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -80,6 +99,39 @@ var (
 		},
 		[]string{"reason", "strategy", "succeeded", "target"},
 	)
+
+	ReleaseFailedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_failed_total",
+			Help: "Total number of releases that finished unsuccessfully, including the invalid ones",
+		},
+		[]string{"application", "namespace"},
+	)
+
+	ReleaseLeadTimeSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "release_lead_time_seconds",
+			Help:    "Release durations from the moment the release resource was created til the release succeeded",
+			Buckets: []float64{60, 300, 600, 1200, 1800, 3600, 7200, 14400, 28800, 86400},
+		},
+		[]string{"application"},
+	)
+
+	ReleasePipelineDurationSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "release_pipeline_duration_seconds",
+			Help:    "Release durations from the moment the release resource was created til the release PipelineRun completed",
+			Buckets: []float64{60, 150, 300, 450, 600, 750, 900, 1050, 1200, 1800, 3600},
+		},
+	)
+
+	ReleaseTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_total",
+			Help: "Total number of releases that finished, no matter the outcome",
+		},
+		[]string{"application", "namespace"},
+	)
 )
 
 // RegisterCompletedRelease decrements the 'release_attempt_concurrent_total' metric, increments `release_attempt_total`
@@ -90,7 +142,7 @@ func RegisterCompletedRelease(reason, strategy, target string, startTime, comple
 		"reason":    reason,
 		"strategy":  strategy,
 		"succeeded": strconv.FormatBool(succeeded),
-		"target":    target,
+		"target":    getTargetLabelValue(target),
 	}
 
 	ReleaseAttemptConcurrentTotal.Dec()
@@ -105,7 +157,7 @@ func RegisterDeployedRelease(reason, target, succeeded string, startTime, comple
 	labels := prometheus.Labels{
 		"reason":    reason,
 		"succeeded": succeeded,
-		"target":    target,
+		"target":    getTargetLabelValue(target),
 	}
 
 	ReleaseAttemptDeploymentSeconds.Observe(completionTime.Sub(startTime.Time).Seconds())
@@ -131,6 +183,64 @@ func RegisterNewRelease(creationTime metav1.Time, startTime *metav1.Time) {
 	ReleaseAttemptRunningSeconds.Observe(startTime.Sub(creationTime.Time).Seconds())
 }
 
+// RegisterReleaseLeadTime registers a new observation for 'release_lead_time_seconds' with the elapsed time from the
+// moment the Release was created to when it succeeded.
+func RegisterReleaseLeadTime(application string, creationTime metav1.Time, completionTime *metav1.Time) {
+	ReleaseLeadTimeSeconds.With(prometheus.Labels{"application": getApplicationLabelValue(application)}).
+		Observe(completionTime.Sub(creationTime.Time).Seconds())
+}
+
+// RegisterReleaseOutcome increments the 'release_total' metric and, for unsuccessful Releases, the
+// 'release_failed_total' one, both labeled by the Release application and namespace. If a completion time is given, a
+// new observation for 'release_pipeline_duration_seconds' is registered with the elapsed time from the moment the
+// Release was created. Invalid Releases don't run a release PipelineRun, so they are registered without completion
+// time.
+func RegisterReleaseOutcome(namespace, application string, creationTime metav1.Time, completionTime *metav1.Time, succeeded bool) {
+	labels := prometheus.Labels{
+		"application": getApplicationLabelValue(application),
+		"namespace":   namespace,
+	}
+
+	ReleaseTotal.With(labels).Inc()
+	if !succeeded {
+		ReleaseFailedTotal.With(labels).Inc()
+	}
+
+	if completionTime != nil {
+		ReleasePipelineDurationSeconds.Observe(completionTime.Sub(creationTime.Time).Seconds())
+	}
+}
+
+// getApplicationLabelValue returns the value to use for the application label of the given application. To keep the
+// cardinality of the metrics bounded, if an allowlist of applications is set in the RELEASE_METRICS_APPLICATIONS
+// environment variable, any application not included in it is bucketed under the 'other' value.
+func getApplicationLabelValue(application string) string {
+	return getAllowlistedLabelValue(application, os.Getenv(applicationsAllowlistEnvVar), otherApplicationLabelValue)
+}
+
+// getTargetLabelValue returns the value to use for the target label of the given target namespace. To keep the
+// cardinality of the metrics bounded, if an allowlist of namespaces is set in the RELEASE_METRICS_TARGET_NAMESPACES
+// environment variable, any target not included in it is bucketed under the 'other' value.
+func getTargetLabelValue(target string) string {
+	return getAllowlistedLabelValue(target, os.Getenv(targetNamespacesAllowlistEnvVar), otherTargetLabelValue)
+}
+
+// getAllowlistedLabelValue returns the given value if the given comma-separated allowlist is empty or includes it.
+// Otherwise, the given other value is returned. Empty values are always returned as is.
+func getAllowlistedLabelValue(value, allowlist, other string) string {
+	if allowlist == "" || value == "" {
+		return value
+	}
+
+	for _, allowed := range strings.Split(allowlist, ",") {
+		if strings.TrimSpace(allowed) == value {
+			return value
+		}
+	}
+
+	return other
+}
+
 func init() {
 	metrics.Registry.MustRegister(
 		ReleaseAttemptConcurrentTotal,
@@ -140,5 +250,9 @@ func init() {
 		ReleaseAttemptInvalidTotal,
 		ReleaseAttemptRunningSeconds,
 		ReleaseAttemptTotal,
+		ReleaseFailedTotal,
+		ReleaseLeadTimeSeconds,
+		ReleasePipelineDurationSeconds,
+		ReleaseTotal,
 	)
 }
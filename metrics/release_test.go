@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -252,4 +253,144 @@ var _ = Describe("Metrics Release", Ordered, func() {
 				strings.NewReader(readerData))).To(Succeed())
 		})
 	})
+
+	Context("When RegisterReleaseOutcome is called", func() {
+		var (
+			FailedTotalHeader = inputHeader{
+				Name: "release_failed_total",
+				Help: "Total number of releases that finished unsuccessfully, including the invalid ones",
+			}
+			PipelineDurationSecondsHeader = inputHeader{
+				Name: "release_pipeline_duration_seconds",
+				Help: "Release durations from the moment the release resource was created til the release PipelineRun completed",
+			}
+			TotalHeader = inputHeader{
+				Name: "release_total",
+				Help: "Total number of releases that finished, no matter the outcome",
+			}
+		)
+
+		BeforeAll(func() {
+			metrics.Registry.Unregister(ReleasePipelineDurationSeconds)
+			ReleasePipelineDurationSeconds = prometheus.NewHistogram(
+				prometheus.HistogramOpts{
+					Name:    "release_pipeline_duration_seconds",
+					Help:    "Release durations from the moment the release resource was created til the release PipelineRun completed",
+					Buckets: []float64{60, 600, 1800, 3600},
+				},
+			)
+			metrics.Registry.MustRegister(ReleasePipelineDurationSeconds)
+		})
+
+		AfterAll(func() {
+			metrics.Registry.Unregister(ReleasePipelineDurationSeconds)
+		})
+
+		It("increments the 'release_total' and 'release_failed_total' metrics of the namespace after a failure", func() {
+			creationTime := metav1.Time{}
+			completionTime := metav1.NewTime(creationTime.Add(500 * time.Second))
+			RegisterReleaseOutcome("failing", "application", creationTime, &completionTime, false)
+			RegisterReleaseOutcome("failing", "application", creationTime, nil, false)
+			RegisterReleaseOutcome("failing", "application", creationTime, &completionTime, true)
+
+			labels := `application="application", namespace="failing",`
+			readerData := createCounterReader(FailedTotalHeader, labels, true, 2) +
+				createCounterReader(TotalHeader, labels, true, 3)
+			Expect(testutil.GatherAndCompare(metrics.Registry, strings.NewReader(readerData),
+				"release_failed_total", "release_total")).To(Succeed())
+		})
+
+		It("registers a new observation for 'release_pipeline_duration_seconds' only if a completion time is given", func() {
+			timeBuckets := []string{"60", "600", "1800", "3600"}
+			data := []int{0, 2, 2, 2}
+			readerData := createHistogramReader(PipelineDurationSecondsHeader, timeBuckets, data, "", 1000, 2)
+			Expect(testutil.CollectAndCompare(ReleasePipelineDurationSeconds, strings.NewReader(readerData))).To(Succeed())
+		})
+	})
+
+	Context("When RegisterReleaseLeadTime is called", func() {
+		LeadTimeSecondsHeader := inputHeader{
+			Name: "release_lead_time_seconds",
+			Help: "Release durations from the moment the release resource was created til the release succeeded",
+		}
+
+		BeforeAll(func() {
+			metrics.Registry.Unregister(ReleaseLeadTimeSeconds)
+			ReleaseLeadTimeSeconds = prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    "release_lead_time_seconds",
+					Help:    "Release durations from the moment the release resource was created til the release succeeded",
+					Buckets: []float64{60, 600, 1800, 3600},
+				},
+				[]string{"application"},
+			)
+			metrics.Registry.MustRegister(ReleaseLeadTimeSeconds)
+		})
+
+		AfterAll(func() {
+			metrics.Registry.Unregister(ReleaseLeadTimeSeconds)
+			os.Unsetenv(applicationsAllowlistEnvVar)
+		})
+
+		It("registers a new observation for 'release_lead_time_seconds' with the time elapsed since the Release creation", func() {
+			creationTime := metav1.Time{}
+			elapsedSeconds := 0.0
+			for _, seconds := range []float64{30, 500, 1500, 3000} {
+				completionTime := metav1.NewTime(creationTime.Add(time.Second * time.Duration(seconds)))
+				elapsedSeconds += seconds
+				RegisterReleaseLeadTime("application", creationTime, &completionTime)
+			}
+
+			timeBuckets := []string{"60", "600", "1800", "3600"}
+			data := []int{1, 2, 3, 4}
+			readerData := createHistogramReader(LeadTimeSecondsHeader, timeBuckets, data, `application="application",`, elapsedSeconds, 4)
+			Expect(testutil.CollectAndCompare(ReleaseLeadTimeSeconds, strings.NewReader(readerData))).To(Succeed())
+		})
+
+		It("buckets the applications not included in the allowlist under the 'other' value", func() {
+			os.Setenv(applicationsAllowlistEnvVar, "foo")
+			creationTime := metav1.Time{}
+			RegisterReleaseLeadTime("application", creationTime, &creationTime)
+			Expect(testutil.CollectAndCount(ReleaseLeadTimeSeconds)).To(Equal(2))
+			Expect(getApplicationLabelValue("application")).To(Equal(otherApplicationLabelValue))
+			Expect(getApplicationLabelValue("foo")).To(Equal("foo"))
+		})
+	})
+
+	Context("When getTargetLabelValue is called", func() {
+		AfterEach(func() {
+			os.Unsetenv(targetNamespacesAllowlistEnvVar)
+		})
+
+		It("returns the target if no allowlist is set", func() {
+			Expect(getTargetLabelValue(defaultNamespace)).To(Equal(defaultNamespace))
+		})
+
+		It("returns the target if it's part of the allowlist", func() {
+			os.Setenv(targetNamespacesAllowlistEnvVar, "foo, default")
+			Expect(getTargetLabelValue(defaultNamespace)).To(Equal(defaultNamespace))
+		})
+
+		It("returns the 'other' value if the target is not part of the allowlist", func() {
+			os.Setenv(targetNamespacesAllowlistEnvVar, "foo,bar")
+			Expect(getTargetLabelValue(defaultNamespace)).To(Equal(otherTargetLabelValue))
+		})
+
+		It("returns an empty target if no target is passed", func() {
+			os.Setenv(targetNamespacesAllowlistEnvVar, "foo,bar")
+			Expect(getTargetLabelValue("")).To(Equal(""))
+		})
+
+		It("applies the bucketed target label to the 'ReleaseAttemptTotal' metric", func() {
+			os.Setenv(targetNamespacesAllowlistEnvVar, "foo")
+			RegisterCompletedRelease(validReleaseReason, strategy, defaultNamespace, &metav1.Time{}, &metav1.Time{}, true)
+
+			labels := fmt.Sprintf(`reason="%s", strategy="%s", succeeded="true", target="%s",`,
+				validReleaseReason, strategy, otherTargetLabelValue)
+			readerData := createCounterReader(AttemptTotalHeader, labels, true, 1)
+			Expect(testutil.CollectAndCompare(
+				ReleaseAttemptTotal.WithLabelValues(validReleaseReason, strategy, "true", otherTargetLabelValue),
+				strings.NewReader(readerData))).To(Succeed())
+		})
+	})
 })
@@ -0,0 +1,95 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+)
+
+// dispatcherQueueSize is the maximum number of Release outcomes waiting to be notified
+const dispatcherQueueSize = 100
+
+// notification holds the data needed to notify the outcome of a Release.
+type notification struct {
+	release  *v1alpha1.Release
+	webhooks *v1alpha1.Webhooks
+}
+
+// Dispatcher notifies the outcome of the Releases in the background, so reconciles don't wait for the notified
+// endpoints to respond. It implements manager.Runnable, so it's started and stopped along with the controllers.
+type Dispatcher struct {
+	logger   logr.Logger
+	notifier *Notifier
+	queue    chan notification
+	slots    chan struct{}
+}
+
+// NewDispatcher creates a new Dispatcher sending the notifications with the given Notifier.
+func NewDispatcher(notifier *Notifier, logger logr.Logger) *Dispatcher {
+	return &Dispatcher{
+		logger:   logger,
+		notifier: notifier,
+		queue:    make(chan notification, dispatcherQueueSize),
+		slots:    make(chan struct{}, dispatcherQueueSize),
+	}
+}
+
+// Reserve reserves a slot in the queue, so a notification can be dispatched later without being dropped. If the queue
+// is full, false is returned. Every reserved slot has to be used by Dispatch or freed by CancelReservation.
+func (d *Dispatcher) Reserve() bool {
+	select {
+	case d.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// CancelReservation frees a slot reserved with Reserve that won't be used.
+func (d *Dispatcher) CancelReservation() {
+	<-d.slots
+}
+
+// Dispatch queues the notification of the outcome of the given Release to the given webhooks and to the notification
+// channels of the Release in a slot previously reserved with Reserve. Copies of both are queued, so they can be
+// modified once this function returns.
+func (d *Dispatcher) Dispatch(release *v1alpha1.Release, webhooks *v1alpha1.Webhooks) {
+	d.queue <- notification{release: release.DeepCopy(), webhooks: webhooks.DeepCopy()}
+}
+
+// Start sends the queued notifications one at a time until the given context is done. Notifications are best effort,
+// so failures are logged but not retried.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	d.notifier.SetContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-d.queue:
+			<-d.slots
+			err := d.notifier.NotifyReleaseOutcome(notification.release, notification.webhooks)
+			if err != nil {
+				d.logger.Error(err, "Unable to send the Release notification",
+					"Release.Name", notification.release.Name, "Release.Namespace", notification.release.Namespace)
+			}
+		}
+	}
+}
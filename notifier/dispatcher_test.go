@@ -0,0 +1,136 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		calls      int32
+		dispatcher *Dispatcher
+		release    *v1alpha1.Release
+		server     *httptest.Server
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&calls, 0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		}))
+
+		releaseNotifier := NewNotifier()
+		releaseNotifier.SetAllowedHosts([]string{"127.0.0.1"})
+		dispatcher = NewDispatcher(releaseNotifier, logr.Discard())
+
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+			},
+		}
+		release.MarkRunning()
+		release.MarkSucceeded()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("When Reserve is called", func() {
+		It("reserves a slot until the queue is full", func() {
+			for i := 0; i < dispatcherQueueSize; i++ {
+				Expect(dispatcher.Reserve()).To(BeTrue())
+			}
+			Expect(dispatcher.Reserve()).To(BeFalse())
+		})
+
+		It("reserves a slot again once a reservation is cancelled", func() {
+			for i := 0; i < dispatcherQueueSize; i++ {
+				Expect(dispatcher.Reserve()).To(BeTrue())
+			}
+			dispatcher.CancelReservation()
+			Expect(dispatcher.Reserve()).To(BeTrue())
+		})
+
+		It("reserves a slot again once a dispatched notification is sent", func() {
+			for i := 0; i < dispatcherQueueSize; i++ {
+				Expect(dispatcher.Reserve()).To(BeTrue())
+			}
+			dispatcher.Dispatch(release, &v1alpha1.Webhooks{
+				OnSuccess: &v1alpha1.Webhook{URL: server.URL},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() { _ = dispatcher.Start(ctx) }()
+
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+			Expect(dispatcher.Reserve()).To(BeTrue())
+		})
+	})
+
+	Context("When Dispatch is called", func() {
+		It("sends the notification once the dispatcher is started", func() {
+			Expect(dispatcher.Reserve()).To(BeTrue())
+			dispatcher.Dispatch(release, &v1alpha1.Webhooks{
+				OnSuccess: &v1alpha1.Webhook{URL: server.URL},
+			})
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(0)))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() { _ = dispatcher.Start(ctx) }()
+
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+		})
+
+		It("sends the Release as it was when it was dispatched", func() {
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: server.URL},
+			}
+			Expect(dispatcher.Reserve()).To(BeTrue())
+			dispatcher.Dispatch(release, nil)
+			release.Spec.NotificationChannels = nil
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() { _ = dispatcher.Start(ctx) }()
+
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+		})
+	})
+
+	Context("When Start is called", func() {
+		It("returns once the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			Expect(dispatcher.Start(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,268 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// releaseConditionType is the type of the Release condition holding its outcome
+	releaseConditionType = "Succeeded"
+
+	// requestTimeout is the maximum time to wait for a webhook to respond
+	requestTimeout = 10 * time.Second
+
+	// slackChannelType is the type of the notification channels receiving a Slack message
+	slackChannelType = "slack"
+
+	// webhookChannelType is the type of the notification channels receiving the json payload
+	webhookChannelType = "webhook"
+)
+
+// Payload holds the data posted to the webhooks once a Release finishes.
+type Payload struct {
+	// Name is the name of the Release
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// Succeeded indicates whether the Release succeeded or not
+	Succeeded bool `json:"succeeded"`
+
+	// ReleasePipelineRun is the namespaced name of the release PipelineRun
+	ReleasePipelineRun string `json:"releasePipelineRun,omitempty"`
+
+	// Target is the namespace where the Release was executed
+	Target string `json:"target,omitempty"`
+
+	// CompletionTime is the time when the Release finished, in RFC 3339 format
+	CompletionTime string `json:"completionTime,omitempty"`
+
+	// Reason is the reason of the Release failure. It's only set for failed Releases
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message describing the Release failure. It's only set for failed Releases
+	Message string `json:"message,omitempty"`
+}
+
+// SlackMessage holds the data posted to the Slack notification channels once a Release finishes.
+type SlackMessage struct {
+	// Text is the message describing the outcome of the Release
+	Text string `json:"text"`
+}
+
+type Notifier struct {
+	allowedHosts map[string]bool
+	client       *http.Client
+	ctx          context.Context
+}
+
+// NewNotifier creates a new Notifier.
+func NewNotifier() *Notifier {
+	return NewNotifierWithContext(context.TODO())
+}
+
+// NewNotifierWithContext creates a new Notifier with the given context.
+func NewNotifierWithContext(ctx context.Context) *Notifier {
+	return &Notifier{
+		client: &http.Client{Timeout: requestTimeout},
+		ctx:    ctx,
+	}
+}
+
+// SetContext sets a new context for the Notifier.
+func (n *Notifier) SetContext(ctx context.Context) {
+	n.ctx = ctx
+}
+
+// SetAllowedHosts sets the hosts the notification channels of the Releases can point to. Channels pointing to other
+// hosts are never notified, so Releases can't make the controller post to arbitrary endpoints. If no hosts are set, the
+// notification channels of the Releases are not notified at all.
+func (n *Notifier) SetAllowedHosts(hosts []string) {
+	n.allowedHosts = make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		n.allowedHosts[strings.ToLower(host)] = true
+	}
+}
+
+// NotifyReleaseOutcome posts the outcome of the given Release to the matching webhook and to the notification channels
+// of the Release. The OnSuccess webhook is used for succeeded Releases and the OnFailure one for failed Releases.
+// Destinations with the same type and endpoint are only notified once. If the Release hasn't finished or it succeeded
+// but only wants to be notified on failure, no notification will be sent. A failure to notify a destination doesn't
+// prevent the others from being notified, and all the failures are returned together, including the notification
+// channels skipped for pointing to hosts that are not allowed.
+func (n *Notifier) NotifyReleaseOutcome(release *v1alpha1.Release, webhooks *v1alpha1.Webhooks) error {
+	if !release.IsDone() || (release.HasSucceeded() && release.Spec.NotifyOnlyOnFailure) {
+		return nil
+	}
+
+	payload := NewPayload(release)
+
+	var errs []error
+	for _, destination := range getNotificationDestinations(release, webhooks) {
+		channel := destination.channel
+		if !destination.managed && !n.isHostAllowed(channel.Endpoint) {
+			errs = append(errs, fmt.Errorf("notification channel %s doesn't point to an allowed host", channel.Endpoint))
+			continue
+		}
+
+		var err error
+		if channel.Type == slackChannelType {
+			err = n.send(channel.Endpoint, NewSlackMessage(payload))
+		} else {
+			err = n.send(channel.Endpoint, payload)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// NewPayload creates a new Payload with the outcome of the given Release. Failure details are only included for
+// failed Releases.
+func NewPayload(release *v1alpha1.Release) *Payload {
+	payload := &Payload{
+		Name:               release.Name,
+		Namespace:          release.Namespace,
+		Succeeded:          release.HasSucceeded(),
+		ReleasePipelineRun: release.Status.ReleasePipelineRun,
+		Target:             release.Status.Target,
+	}
+
+	if release.Status.CompletionTime != nil {
+		payload.CompletionTime = release.Status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+
+	if !payload.Succeeded {
+		condition := meta.FindStatusCondition(release.Status.Conditions, releaseConditionType)
+		if condition != nil {
+			payload.Reason = condition.Reason
+			payload.Message = condition.Message
+		}
+	}
+
+	return payload
+}
+
+// NewSlackMessage creates a new SlackMessage describing the outcome in the given Payload.
+func NewSlackMessage(payload *Payload) *SlackMessage {
+	text := fmt.Sprintf("Release %s/%s succeeded", payload.Namespace, payload.Name)
+	if !payload.Succeeded {
+		text = fmt.Sprintf("Release %s/%s failed", payload.Namespace, payload.Name)
+		if payload.Reason != "" {
+			text = fmt.Sprintf("%s (%s)", text, payload.Reason)
+		}
+		if payload.Message != "" {
+			text = fmt.Sprintf("%s: %s", text, payload.Message)
+		}
+	}
+
+	return &SlackMessage{Text: text}
+}
+
+// notificationDestination is a notification channel along with whether it's declared by the managed side, in which case
+// it's not restricted to the allowed hosts.
+type notificationDestination struct {
+	channel v1alpha1.NotificationChannel
+	managed bool
+}
+
+// getNotificationDestinations returns the destinations to notify of the outcome of the given Release. That is the
+// webhook declared in the given Webhooks for that outcome, if any, followed by the notification channels of the
+// Release. Channels with the same type and endpoint as a previous one are left out.
+func getNotificationDestinations(release *v1alpha1.Release, webhooks *v1alpha1.Webhooks) []notificationDestination {
+	var candidates []notificationDestination
+	if webhooks != nil {
+		webhook := webhooks.OnFailure
+		if release.HasSucceeded() {
+			webhook = webhooks.OnSuccess
+		}
+		if webhook != nil && webhook.URL != "" {
+			candidates = append(candidates, notificationDestination{
+				channel: v1alpha1.NotificationChannel{Type: webhookChannelType, Endpoint: webhook.URL},
+				managed: true,
+			})
+		}
+	}
+	for _, channel := range release.Spec.NotificationChannels {
+		candidates = append(candidates, notificationDestination{channel: channel})
+	}
+
+	var destinations []notificationDestination
+	seen := map[v1alpha1.NotificationChannel]bool{}
+	for _, candidate := range candidates {
+		if candidate.channel.Endpoint == "" || seen[candidate.channel] {
+			continue
+		}
+		seen[candidate.channel] = true
+		destinations = append(destinations, candidate)
+	}
+
+	return destinations
+}
+
+// isHostAllowed returns whether the host of the given url is one of the allowed hosts of the Notifier.
+func (n *Notifier) isHostAllowed(endpoint string) bool {
+	parsedURL, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	return n.allowedHosts[strings.ToLower(parsedURL.Hostname())]
+}
+
+// send posts the json representation of the given payload to the given url. An error will be returned if the
+// request can't be sent or the endpoint doesn't respond with a 2xx status code.
+func (n *Notifier) send(url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(n.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook %s responded with status code %d", url, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		failureServer, successServer     *httptest.Server
+		failurePayloads, successPayloads []Payload
+		notifier                         *Notifier
+		release                          *v1alpha1.Release
+		webhooks                         *v1alpha1.Webhooks
+	)
+
+	newServer := func(payloads *[]Payload) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload Payload
+			Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+			*payloads = append(*payloads, payload)
+		}))
+	}
+
+	BeforeEach(func() {
+		failurePayloads, successPayloads = nil, nil
+		failureServer = newServer(&failurePayloads)
+		successServer = newServer(&successPayloads)
+
+		notifier = NewNotifier()
+		notifier.SetAllowedHosts([]string{"127.0.0.1"})
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+			},
+		}
+		release.MarkRunning()
+		webhooks = &v1alpha1.Webhooks{
+			OnSuccess: &v1alpha1.Webhook{URL: successServer.URL},
+			OnFailure: &v1alpha1.Webhook{URL: failureServer.URL},
+		}
+	})
+
+	AfterEach(func() {
+		failureServer.Close()
+		successServer.Close()
+	})
+
+	Context("When NewNotifier is called", func() {
+		It("creates and return a new notifier", func() {
+			Expect(reflect.TypeOf(NewNotifier())).To(Equal(reflect.TypeOf(&Notifier{})))
+		})
+	})
+
+	Context("When NotifyReleaseOutcome is called", func() {
+		It("does nothing if the Release hasn't finished", func() {
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(BeEmpty())
+			Expect(failurePayloads).To(BeEmpty())
+		})
+
+		It("does nothing if no webhooks are declared", func() {
+			release.MarkSucceeded()
+			Expect(notifier.NotifyReleaseOutcome(release, nil)).To(Succeed())
+			Expect(successPayloads).To(BeEmpty())
+		})
+
+		It("does nothing if no webhook is declared for the Release outcome", func() {
+			release.MarkSucceeded()
+			webhooks.OnSuccess = nil
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(failurePayloads).To(BeEmpty())
+		})
+
+		It("notifies the OnSuccess webhook if the Release succeeded", func() {
+			release.MarkSucceeded()
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(failurePayloads).To(BeEmpty())
+			Expect(successPayloads).To(HaveLen(1))
+			Expect(successPayloads[0].Name).To(Equal(release.Name))
+			Expect(successPayloads[0].Succeeded).To(BeTrue())
+			Expect(successPayloads[0].Reason).To(BeEmpty())
+		})
+
+		It("does nothing if the Release succeeded but only wants to be notified on failure", func() {
+			release.Spec.NotifyOnlyOnFailure = true
+			release.MarkSucceeded()
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(BeEmpty())
+			Expect(failurePayloads).To(BeEmpty())
+		})
+
+		It("notifies the OnFailure webhook if the Release failed and only wants to be notified on failure", func() {
+			release.Spec.NotifyOnlyOnFailure = true
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "pipeline failed")
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(BeEmpty())
+			Expect(failurePayloads).To(HaveLen(1))
+			Expect(failurePayloads[0].Succeeded).To(BeFalse())
+		})
+
+		It("notifies the OnFailure webhook with the failure details if the Release failed", func() {
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "pipeline failed")
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(BeEmpty())
+			Expect(failurePayloads).To(HaveLen(1))
+			Expect(failurePayloads[0].Succeeded).To(BeFalse())
+			Expect(failurePayloads[0].Reason).To(Equal(v1alpha1.ReleaseReasonPipelineFailed.String()))
+			Expect(failurePayloads[0].Message).To(Equal("pipeline failed"))
+		})
+
+		It("fails if the webhook doesn't respond with a successful status code", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			release.MarkSucceeded()
+			webhooks.OnSuccess.URL = server.URL
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).NotTo(Succeed())
+		})
+
+		It("notifies every notification channel of the Release along with the webhook", func() {
+			var slackMessages []SlackMessage
+			slackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var message SlackMessage
+				Expect(json.NewDecoder(r.Body).Decode(&message)).To(Succeed())
+				slackMessages = append(slackMessages, message)
+			}))
+			defer slackServer.Close()
+
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: failureServer.URL},
+				{Type: "slack", Endpoint: slackServer.URL},
+			}
+			release.MarkSucceeded()
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(HaveLen(1))
+			Expect(failurePayloads).To(HaveLen(1))
+			Expect(failurePayloads[0].Succeeded).To(BeTrue())
+			Expect(slackMessages).To(HaveLen(1))
+			Expect(slackMessages[0].Text).To(Equal("Release default/release succeeded"))
+		})
+
+		It("notifies the notification channels of the Release if no webhooks are declared", func() {
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: failureServer.URL},
+			}
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "pipeline failed")
+			Expect(notifier.NotifyReleaseOutcome(release, nil)).To(Succeed())
+			Expect(failurePayloads).To(HaveLen(1))
+		})
+
+		It("notifies each destination only once", func() {
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: successServer.URL},
+				{Type: "webhook", Endpoint: successServer.URL},
+			}
+			release.MarkSucceeded()
+			Expect(notifier.NotifyReleaseOutcome(release, webhooks)).To(Succeed())
+			Expect(successPayloads).To(HaveLen(1))
+		})
+
+		It("notifies the other destinations if one of them fails", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: server.URL},
+				{Type: "webhook", Endpoint: failureServer.URL},
+			}
+			release.MarkSucceeded()
+			err := notifier.NotifyReleaseOutcome(release, webhooks)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("responded with status code 500"))
+			Expect(successPayloads).To(HaveLen(1))
+			Expect(failurePayloads).To(HaveLen(1))
+		})
+
+		It("doesn't notify the notification channels of the Release pointing to hosts that aren't allowed", func() {
+			release.Spec.NotificationChannels = []v1alpha1.NotificationChannel{
+				{Type: "webhook", Endpoint: strings.Replace(failureServer.URL, "127.0.0.1", "localhost", 1)},
+			}
+			release.MarkSucceeded()
+			err := notifier.NotifyReleaseOutcome(release, webhooks)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't point to an allowed host"))
+			Expect(successPayloads).To(HaveLen(1))
+			Expect(failurePayloads).To(BeEmpty())
+		})
+	})
+
+	Context("When NewSlackMessage is called", func() {
+		It("describes the failure details if the Release failed", func() {
+			release.MarkFailed(v1alpha1.ReleaseReasonPipelineFailed, "pipeline failed")
+			message := NewSlackMessage(NewPayload(release))
+			Expect(message.Text).To(Equal("Release default/release failed (" +
+				v1alpha1.ReleaseReasonPipelineFailed.String() + "): pipeline failed"))
+		})
+	})
+
+	Context("When NewPayload is called", func() {
+		It("contains the Release completion time", func() {
+			release.MarkSucceeded()
+			payload := NewPayload(release)
+			Expect(payload.CompletionTime).NotTo(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,165 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+// maxArtifactSize is the maximum size in bytes of the content read from an artifact
+const maxArtifactSize = 1024 * 1024
+
+// Resolver provides the content of OCI artifacts.
+type Resolver interface {
+	// Digest returns the digest of the artifact the given reference points to.
+	Digest(ctx context.Context, reference string) (string, error)
+
+	// Fetch returns the content of the artifact with the given reference and digest.
+	Fetch(ctx context.Context, reference, digest string) ([]byte, error)
+}
+
+// registryResolver is a Resolver pulling the artifacts from their registries.
+type registryResolver struct{}
+
+// NewRegistryResolver creates a new Resolver pulling the artifacts from their registries.
+func NewRegistryResolver() Resolver {
+	return &registryResolver{}
+}
+
+// Digest returns the digest of the artifact the given reference points to.
+func (r *registryResolver) Digest(ctx context.Context, reference string) (string, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return "", err
+	}
+
+	descriptor, err := remote.Head(ref, remote.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	return descriptor.Digest.String(), nil
+}
+
+// Fetch returns the content of the first layer of the artifact with the given reference and digest.
+func (r *registryResolver) Fetch(ctx context.Context, reference, digest string) ([]byte, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := remote.Image(ref.Context().Digest(digest), remote.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := image.Layers()
+	if err != nil {
+		return nil, err
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("artifact %s has no layers", reference)
+	}
+
+	content, err := layers[0].Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return io.ReadAll(io.LimitReader(content, maxArtifactSize))
+}
+
+// ReleaseStrategyResolver resolves ReleaseStrategies distributed as OCI artifacts. The parsed ReleaseStrategies are
+// cached by digest, so an artifact is only fetched again when its reference points to new content.
+type ReleaseStrategyResolver struct {
+	cache    map[string]*v1alpha1.ReleaseStrategy
+	mutex    sync.Mutex
+	resolver Resolver
+}
+
+// NewReleaseStrategyResolver creates a new ReleaseStrategyResolver fetching the artifacts with the given Resolver.
+func NewReleaseStrategyResolver(resolver Resolver) *ReleaseStrategyResolver {
+	return &ReleaseStrategyResolver{
+		cache:    map[string]*v1alpha1.ReleaseStrategy{},
+		resolver: resolver,
+	}
+}
+
+// Resolve returns the ReleaseStrategy stored in the artifact the given reference points to. An error will be returned
+// if the artifact can't be fetched or it doesn't contain a ReleaseStrategy.
+func (r *ReleaseStrategyResolver) Resolve(ctx context.Context, reference string) (*v1alpha1.ReleaseStrategy, error) {
+	digest, err := r.resolver.Digest(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve the ReleaseStrategy artifact %s: %w", reference, err)
+	}
+
+	r.mutex.Lock()
+	releaseStrategy, found := r.cache[digest]
+	r.mutex.Unlock()
+	if found {
+		return releaseStrategy.DeepCopy(), nil
+	}
+
+	content, err := r.resolver.Fetch(ctx, reference, digest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the ReleaseStrategy artifact %s: %w", reference, err)
+	}
+
+	releaseStrategy, err = parseReleaseStrategy(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the ReleaseStrategy artifact %s: %w", reference, err)
+	}
+
+	r.mutex.Lock()
+	r.cache[digest] = releaseStrategy
+	r.mutex.Unlock()
+
+	return releaseStrategy.DeepCopy(), nil
+}
+
+// parseReleaseStrategy parses the given YAML or JSON content into a ReleaseStrategy. An error will be returned if the
+// content describes another kind of object, the ReleaseStrategy doesn't reference a pipeline or it doesn't pass the
+// same validation the ReleaseStrategy webhook runs.
+func parseReleaseStrategy(content []byte) (*v1alpha1.ReleaseStrategy, error) {
+	releaseStrategy := &v1alpha1.ReleaseStrategy{}
+	if err := yaml.UnmarshalStrict(content, releaseStrategy); err != nil {
+		return nil, err
+	}
+
+	if releaseStrategy.Kind != "ReleaseStrategy" {
+		return nil, fmt.Errorf("expected a ReleaseStrategy but found kind %q", releaseStrategy.Kind)
+	}
+
+	if releaseStrategy.Spec.Pipeline == "" {
+		return nil, fmt.Errorf("the ReleaseStrategy doesn't reference a pipeline")
+	}
+
+	if err := releaseStrategy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return releaseStrategy, nil
+}
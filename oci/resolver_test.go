@@ -0,0 +1,208 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeResolver is a Resolver serving the artifacts from memory.
+type fakeResolver struct {
+	artifacts map[string][]byte
+	digests   map[string]string
+	fetches   int
+}
+
+func (r *fakeResolver) Digest(_ context.Context, reference string) (string, error) {
+	digest, found := r.digests[reference]
+	if !found {
+		return "", fmt.Errorf("reference %s not found", reference)
+	}
+
+	return digest, nil
+}
+
+func (r *fakeResolver) Fetch(_ context.Context, _, digest string) ([]byte, error) {
+	r.fetches++
+
+	content, found := r.artifacts[digest]
+	if !found {
+		return nil, fmt.Errorf("digest %s not found", digest)
+	}
+
+	return content, nil
+}
+
+var _ = Describe("Resolver", func() {
+	const (
+		reference = "quay.io/redhat-appstudio/release-strategy:latest"
+		digest    = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	)
+
+	var (
+		resolver                *fakeResolver
+		releaseStrategyResolver *ReleaseStrategyResolver
+	)
+
+	BeforeEach(func() {
+		resolver = &fakeResolver{
+			artifacts: map[string][]byte{
+				digest: []byte(`
+apiVersion: appstudio.redhat.com/v1alpha1
+kind: ReleaseStrategy
+metadata:
+  name: strategy
+spec:
+  pipeline: release-pipeline
+  policy: policy
+`),
+			},
+			digests: map[string]string{reference: digest},
+		}
+		releaseStrategyResolver = NewReleaseStrategyResolver(resolver)
+	})
+
+	Context("When NewRegistryResolver is called", func() {
+		It("creates and return a new registry resolver", func() {
+			Expect(reflect.TypeOf(NewRegistryResolver())).To(Equal(reflect.TypeOf(&registryResolver{})))
+		})
+	})
+
+	Context("When Resolve is called", func() {
+		It("returns the ReleaseStrategy stored in the artifact", func() {
+			releaseStrategy, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseStrategy.Name).To(Equal("strategy"))
+			Expect(releaseStrategy.Spec.Pipeline).To(Equal("release-pipeline"))
+			Expect(releaseStrategy.Spec.Policy).To(Equal("policy"))
+		})
+
+		It("only fetches the artifact once for the same digest", func() {
+			_, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolver.fetches).To(Equal(1))
+		})
+
+		It("fetches the artifact again when the reference points to a new digest", func() {
+			newDigest := "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+			resolver.artifacts[newDigest] = []byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "new-pipeline"}}`)
+
+			_, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+
+			resolver.digests[reference] = newDigest
+			releaseStrategy, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseStrategy.Spec.Pipeline).To(Equal("new-pipeline"))
+			Expect(resolver.fetches).To(Equal(2))
+		})
+
+		It("returns copies that don't modify the cached ReleaseStrategy", func() {
+			releaseStrategy, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			releaseStrategy.Spec.Pipeline = "modified"
+
+			releaseStrategy, err = releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releaseStrategy.Spec.Pipeline).To(Equal("release-pipeline"))
+		})
+
+		It("returns an error if the reference can't be resolved", func() {
+			_, err := releaseStrategyResolver.Resolve(context.TODO(), "quay.io/missing:latest")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to resolve the ReleaseStrategy artifact"))
+		})
+
+		It("returns an error if the artifact can't be fetched", func() {
+			delete(resolver.artifacts, digest)
+
+			_, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to fetch the ReleaseStrategy artifact"))
+		})
+
+		It("returns an error and doesn't cache the artifact if it can't be parsed", func() {
+			resolver.artifacts[digest] = []byte("not a ReleaseStrategy")
+
+			_, err := releaseStrategyResolver.Resolve(context.TODO(), reference)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to parse the ReleaseStrategy artifact"))
+			Expect(releaseStrategyResolver.cache).To(BeEmpty())
+		})
+	})
+
+	Context("When parseReleaseStrategy is called", func() {
+		It("returns an error if the content describes another kind of object", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleasePlan", "spec": {"pipeline": "release-pipeline"}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected a ReleaseStrategy"))
+		})
+
+		It("returns an error if the ReleaseStrategy doesn't reference a pipeline", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"policy": "policy"}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't reference a pipeline"))
+		})
+
+		It("returns an error if the content has unknown fields", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", "unknown": "x"}}`))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error if the PipelineRun template references a pipeline", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", ` +
+				`"pipelineRunTemplate": {"pipelineSpec": {"tasks": []}}}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("pipelineRunTemplate can't reference a pipeline"))
+		})
+
+		It("returns an error if the PipelineRun template sets the PipelineRun status", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", ` +
+				`"pipelineRunTemplate": {"status": "PipelineRunPending"}}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("pipelineRunTemplate can't set the PipelineRun status"))
+		})
+
+		It("returns an error if the PipelineRun template is not a valid PipelineRunSpec", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", ` +
+				`"pipelineRunTemplate": {"unknown": "x"}}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid pipelineRunTemplate"))
+		})
+
+		It("returns an error if two params collide once normalized", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", ` +
+				`"params": [{"name": "foo", "value": "a"}, {"name": " foo", "value": "b"}]}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("collide once normalized"))
+		})
+
+		It("returns an error if a workspace is declared more than once", func() {
+			_, err := parseReleaseStrategy([]byte(`{"kind": "ReleaseStrategy", "spec": {"pipeline": "p", ` +
+				`"workspaces": [{"name": "data", "persistentVolumeClaim": "a"}, {"name": "data", "persistentVolumeClaim": "b"}]}}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("declared more than once"))
+		})
+	})
+})
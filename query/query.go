@@ -0,0 +1,220 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"github.com/redhat-appstudio/release-service/loader"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// pathPrefix is the prefix of the path used to query Releases. The full path has the form
+	// /api/v1alpha1/namespaces/<namespace>/releases/<name>/plan
+	pathPrefix = "/api/v1alpha1/namespaces/"
+
+	// readHeaderTimeout is the maximum time to wait for the headers of a request
+	readHeaderTimeout = 10 * time.Second
+
+	// shutdownTimeout is the maximum time to wait for in-flight requests when the server is stopped
+	shutdownTimeout = 10 * time.Second
+)
+
+// Plan holds the data returned by the query API for a given Release.
+type Plan struct {
+	// Name is the name of the Release
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Release
+	Namespace string `json:"namespace"`
+
+	// ReleasePlan is the namespaced name of the ReleasePlan referenced by the Release
+	ReleasePlan string `json:"releasePlan"`
+
+	// ReleasePlanAdmission is the namespaced name of the ReleasePlanAdmission matching the ReleasePlan. It's omitted
+	// if no active ReleasePlanAdmission can be found
+	ReleasePlanAdmission string `json:"releasePlanAdmission,omitempty"`
+
+	// ReleaseStrategy is the namespaced name of the ReleaseStrategy used for the Release
+	ReleaseStrategy string `json:"releaseStrategy,omitempty"`
+
+	// Target is the namespace where the Release is executed
+	Target string `json:"target,omitempty"`
+
+	// Params are the ReleaseStrategy params resolved for the Release
+	Params []v1alpha1.Params `json:"params,omitempty"`
+
+	// Status is the status of the Release
+	Status v1alpha1.ReleaseStatus `json:"status"`
+}
+
+// Server is a read-only HTTP server exposing the resolved plan of Releases to integrations that can't watch the
+// Release resources. Every request has to be authenticated with the bearer token the Server was created with.
+type Server struct {
+	address string
+	client  client.Client
+	loader  loader.ObjectLoader
+	token   string
+}
+
+// NewServer creates a new Server listening on the given address and authenticating requests with the given token.
+func NewServer(client client.Client, address, token string) *Server {
+	return &Server{
+		address: address,
+		client:  client,
+		loader:  loader.NewLoader(),
+		token:   token,
+	}
+}
+
+// NeedLeaderElection returns false, so the Server runs in every replica of the manager.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start runs the Server until the given context is cancelled. It implements the manager.Runnable interface, so the
+// Server can be added to a controller-runtime Manager.
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              s.address,
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logf.FromContext(ctx).Error(err, "unable to shut down the query server")
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// ServeHTTP handles the requests to the query API. Only authenticated GET requests to the plan of a Release are
+// served.
+func (s *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if !s.isAuthorized(request) {
+		writer.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if request.Method != http.MethodGet {
+		writer.Header().Set("Allow", http.MethodGet)
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name, namespace, ok := parsePath(request.URL.Path)
+	if !ok {
+		http.NotFound(writer, request)
+		return
+	}
+
+	plan, err := s.getPlan(request.Context(), name, namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			http.Error(writer, fmt.Sprintf("release %s not found", namespacedName(namespace, name)), http.StatusNotFound)
+			return
+		}
+		logf.FromContext(request.Context()).Error(err, "unable to get the release plan", "name", name, "namespace", namespace)
+		http.Error(writer, "unable to get the release plan", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(plan)
+}
+
+// getPlan returns the Plan of the Release with the given name and namespace. The ReleasePlanAdmission is resolved on a
+// best effort basis, so a Release can still be queried if its ReleasePlanAdmission is gone.
+func (s *Server) getPlan(ctx context.Context, name, namespace string) (*Plan, error) {
+	release, err := s.loader.GetRelease(ctx, s.client, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		Name:            release.Name,
+		Namespace:       release.Namespace,
+		ReleasePlan:     namespacedName(release.Namespace, release.Spec.ReleasePlan),
+		ReleaseStrategy: release.Status.ReleaseStrategy,
+		Target:          release.Status.Target,
+		Params:          release.Status.Params,
+		Status:          release.Status,
+	}
+
+	releasePlanAdmission, err := s.loader.GetActiveReleasePlanAdmissionFromRelease(ctx, s.client, release)
+	if err == nil && releasePlanAdmission != nil {
+		plan.ReleasePlanAdmission = namespacedName(releasePlanAdmission.Namespace, releasePlanAdmission.Name)
+	}
+
+	return plan, nil
+}
+
+// isAuthorized returns whether the given request carries the bearer token of the Server. Requests are always rejected
+// if the Server has no token.
+func (s *Server) isAuthorized(request *http.Request) bool {
+	authorization := request.Header.Get("Authorization")
+	if s.token == "" || !strings.HasPrefix(authorization, "Bearer ") {
+		return false
+	}
+
+	token := strings.TrimPrefix(authorization, "Bearer ")
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// namespacedName returns the namespaced name of the object with the given name and namespace.
+func namespacedName(namespace, name string) string {
+	return fmt.Sprintf("%s%c%s", namespace, types.Separator, name)
+}
+
+// parsePath returns the name and namespace of the Release referenced in the given path. If the path doesn't match the
+// form /api/v1alpha1/namespaces/<namespace>/releases/<name>/plan, false is returned.
+func parsePath(path string) (string, string, bool) {
+	if !strings.HasPrefix(path, pathPrefix) {
+		return "", "", false
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, pathPrefix), "/")
+	if len(segments) != 4 || segments[1] != "releases" || segments[3] != "plan" ||
+		segments[0] == "" || segments[2] == "" {
+		return "", "", false
+	}
+
+	return segments[2], segments[0], true
+}
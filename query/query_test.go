@@ -0,0 +1,272 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	"github.com/redhat-appstudio/release-service/loader"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("Query", func() {
+	const (
+		path  = "/api/v1alpha1/namespaces/default/releases/release/plan"
+		token = "token"
+	)
+
+	var (
+		release *v1alpha1.Release
+		server  *Server
+	)
+
+	newRequest := func(method, path string, data []loader.MockData) *http.Request {
+		request := httptest.NewRequest(method, path, nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		return request.WithContext(loader.GetMockedContext(context.TODO(), data))
+	}
+
+	BeforeEach(func() {
+		release = &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.ReleaseSpec{
+				ReleasePlan: "release-plan",
+			},
+			Status: v1alpha1.ReleaseStatus{
+				ReleaseStrategy: "managed/release-strategy",
+				Target:          "managed",
+				Params: []v1alpha1.Params{
+					{Name: "foo", Value: "bar"},
+				},
+			},
+		}
+
+		server = NewServer(nil, ":0", token)
+		server.loader = loader.NewMockLoader()
+	})
+
+	Context("When NewServer is called", func() {
+		It("creates and return a new server", func() {
+			Expect(reflect.TypeOf(NewServer(nil, ":0", token))).To(Equal(reflect.TypeOf(&Server{})))
+		})
+	})
+
+	Context("When NeedLeaderElection is called", func() {
+		It("returns false", func() {
+			Expect(server.NeedLeaderElection()).To(BeFalse())
+		})
+	})
+
+	Context("When ServeHTTP is called", func() {
+		It("returns the resolved plan of the release", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodGet, path, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   release,
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource: &v1alpha1.ReleasePlanAdmission{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan-admission",
+							Namespace: "managed",
+						},
+					},
+				},
+			}))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			plan := &Plan{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), plan)).To(Succeed())
+			Expect(plan.Name).To(Equal("release"))
+			Expect(plan.Namespace).To(Equal("default"))
+			Expect(plan.ReleasePlan).To(Equal("default/release-plan"))
+			Expect(plan.ReleasePlanAdmission).To(Equal("managed/release-plan-admission"))
+			Expect(plan.ReleaseStrategy).To(Equal("managed/release-strategy"))
+			Expect(plan.Target).To(Equal("managed"))
+			Expect(plan.Params).To(Equal(release.Status.Params))
+			Expect(plan.Status.Target).To(Equal("managed"))
+		})
+
+		It("omits the release plan admission if it can't be found", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodGet, path, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Resource:   release,
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("not found"),
+				},
+			}))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			plan := &Plan{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), plan)).To(Succeed())
+			Expect(plan.ReleasePlanAdmission).To(BeEmpty())
+		})
+
+		It("returns not found if the release doesn't exist", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodGet, path, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, "release"),
+				},
+			}))
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns an internal server error if the release can't be loaded", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodGet, path, []loader.MockData{
+				{
+					ContextKey: loader.ReleaseContextKey,
+					Err:        fmt.Errorf("internal error"),
+				},
+			}))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("returns not found if the path is not a release plan", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodGet, "/api/v1alpha1/namespaces/default/releases", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("rejects methods other than GET", func() {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, newRequest(http.MethodPost, path, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			Expect(recorder.Header().Get("Allow")).To(Equal(http.MethodGet))
+		})
+
+		It("rejects requests without a token", func() {
+			request := newRequest(http.MethodGet, path, nil)
+			request.Header.Del("Authorization")
+
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("rejects requests with a wrong token", func() {
+			request := newRequest(http.MethodGet, path, nil)
+			request.Header.Set("Authorization", "Bearer wrong")
+
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("rejects every request if the server has no token", func() {
+			server.token = ""
+			request := newRequest(http.MethodGet, path, nil)
+			request.Header.Set("Authorization", "Bearer ")
+
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("When the server is reached through HTTP", func() {
+		It("serves the release plan", func() {
+			httpServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				server.ServeHTTP(writer, request.WithContext(loader.GetMockedContext(request.Context(), []loader.MockData{
+					{
+						ContextKey: loader.ReleaseContextKey,
+						Resource:   release,
+					},
+					{
+						ContextKey: loader.ReleasePlanAdmissionContextKey,
+						Err:        fmt.Errorf("not found"),
+					},
+				})))
+			}))
+			defer httpServer.Close()
+
+			request, err := http.NewRequest(http.MethodGet, httpServer.URL+path, nil)
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Authorization", "Bearer "+token)
+
+			response, err := httpServer.Client().Do(request)
+			Expect(err).NotTo(HaveOccurred())
+			defer response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			plan := &Plan{}
+			Expect(json.NewDecoder(response.Body).Decode(plan)).To(Succeed())
+			Expect(plan.Name).To(Equal("release"))
+		})
+	})
+
+	Context("When Start is called", func() {
+		It("stops once the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			cancel()
+			Expect(NewServer(nil, "127.0.0.1:0", token).Start(ctx)).To(Succeed())
+		})
+	})
+
+	Context("When parsePath is called", func() {
+		It("returns the name and namespace of the release", func() {
+			name, namespace, ok := parsePath(path)
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("release"))
+			Expect(namespace).To(Equal("default"))
+		})
+
+		It("returns false for paths not matching a release plan", func() {
+			for _, path := range []string{
+				"/",
+				"/api/v1alpha1/namespaces/default/releases/release",
+				"/api/v1alpha1/namespaces/default/releases//plan",
+				"/api/v1alpha1/namespaces/default/snapshots/release/plan",
+				"/api/v1alpha1/namespaces/default/releases/release/plan/extra",
+			} {
+				_, _, ok := parsePath(path)
+				Expect(ok).To(BeFalse(), path)
+			}
+		})
+	})
+})
@@ -0,0 +1,103 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"context"
+	"unicode/utf8"
+
+	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/apis"
+)
+
+// truncatedLogPrefix is the prefix added to logs that had to be truncated
+const truncatedLogPrefix = "..."
+
+// LogSource provides the logs of the containers running the steps of TaskRuns.
+type LogSource interface {
+	// GetLogs returns the last tailLines lines of the logs of the given container.
+	GetLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error)
+}
+
+// podLogSource is a LogSource reading the logs from the pods through the Kubernetes API.
+type podLogSource struct {
+	clientset kubernetes.Interface
+}
+
+// NewPodLogSource creates a new LogSource reading the logs from the pods through the Kubernetes API.
+func NewPodLogSource(config *rest.Config) (LogSource, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &podLogSource{clientset: clientset}, nil
+}
+
+// GetLogs returns the last tailLines lines of the logs of the given container.
+func (s *podLogSource) GetLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error) {
+	logs, err := s.clientset.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: containerName,
+		TailLines: &tailLines,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return string(logs), nil
+}
+
+// GetFailedStep returns the names of the pod and container running the first failed step found in the given TaskRuns.
+// If none of the TaskRuns has a failed step, false is returned.
+func GetFailedStep(taskRuns []tektonv1beta1.TaskRun) (string, string, bool) {
+	for _, taskRun := range taskRuns {
+		if !taskRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse() || taskRun.Status.PodName == "" {
+			continue
+		}
+
+		for _, step := range taskRun.Status.Steps {
+			if step.Terminated != nil && step.Terminated.ExitCode != 0 {
+				return taskRun.Status.PodName, step.ContainerName, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// TruncateLog returns the given log limited to its last maxSize bytes. Truncated logs are prefixed with an ellipsis.
+func TruncateLog(log string, maxSize int) string {
+	if len(log) <= maxSize {
+		return log
+	}
+
+	prefix := truncatedLogPrefix
+	if maxSize <= len(prefix) {
+		prefix = ""
+	}
+
+	// Avoid starting the excerpt in the middle of a multi-byte character
+	start := len(log) - maxSize + len(prefix)
+	for start < len(log) && !utf8.RuneStart(log[start]) {
+		start++
+	}
+
+	return prefix + log[start:]
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/apis"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+)
+
+var _ = Describe("Logs", func() {
+
+	newTaskRun := func(status corev1.ConditionStatus, podName string, exitCodes ...int32) tektonv1beta1.TaskRun {
+		taskRun := tektonv1beta1.TaskRun{}
+		taskRun.Status.PodName = podName
+		taskRun.Status.Status = duckv1beta1.Status{
+			Conditions: duckv1beta1.Conditions{{Type: apis.ConditionSucceeded, Status: status}},
+		}
+		for i, exitCode := range exitCodes {
+			taskRun.Status.Steps = append(taskRun.Status.Steps, tektonv1beta1.StepState{
+				ContainerState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+				},
+				ContainerName: "step-" + string(rune('a'+i)),
+			})
+		}
+		return taskRun
+	}
+
+	Context("When NewPodLogSource is called", func() {
+		It("creates and return a new log source", func() {
+			logSource, err := NewPodLogSource(&rest.Config{Host: "localhost"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logSource).NotTo(BeNil())
+		})
+	})
+
+	Context("When GetLogs is called", func() {
+		It("returns the logs of the container", func() {
+			logSource := &podLogSource{clientset: fake.NewSimpleClientset()}
+			logs, err := logSource.GetLogs(ctx, "default", "pod", "step-a", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs).To(Equal("fake logs"))
+		})
+	})
+
+	Context("When GetFailedStep is called", func() {
+		It("returns the pod and container of the first failed step", func() {
+			podName, containerName, found := GetFailedStep([]tektonv1beta1.TaskRun{
+				newTaskRun(corev1.ConditionTrue, "succeeded-pod", 0),
+				newTaskRun(corev1.ConditionFalse, "failed-pod", 0, 1, 1),
+			})
+			Expect(found).To(BeTrue())
+			Expect(podName).To(Equal("failed-pod"))
+			Expect(containerName).To(Equal("step-b"))
+		})
+
+		It("returns false if no step failed", func() {
+			_, _, found := GetFailedStep([]tektonv1beta1.TaskRun{
+				newTaskRun(corev1.ConditionTrue, "succeeded-pod", 0),
+				newTaskRun(corev1.ConditionFalse, "", 1),
+			})
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("When TruncateLog is called", func() {
+		It("returns the log untouched if it fits", func() {
+			Expect(TruncateLog("error", 5)).To(Equal("error"))
+		})
+
+		It("keeps the end of the log if it doesn't fit", func() {
+			log := TruncateLog(strings.Repeat("a", 10)+"error", 10)
+			Expect(log).To(Equal("...aaerror"))
+			Expect(len(log)).To(Equal(10))
+		})
+
+		It("doesn't split multi-byte characters", func() {
+			Expect(TruncateLog("ééééé", 8)).To(Equal("...éé"))
+		})
+	})
+})
@@ -20,6 +20,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"time"
 	"unicode"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
@@ -32,6 +35,8 @@ import (
 	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // PipelineType represents a PipelineRun type within AppStudio
@@ -49,20 +54,62 @@ const (
 
 	//PipelineTypeRelease is the type for PipelineRuns created to run a release Pipeline
 	PipelineTypeRelease = "release"
+
+	// PipelineTypeCollectors is the type for PipelineRuns created to run the collectors of a Release
+	PipelineTypeCollectors = "collectors"
+
+	// ManagedByLabelValue is the value of the managed-by label set in the PipelineRuns created by the release service
+	ManagedByLabelValue = "release-service"
+
+	// EnvParamName is the name of the param holding the environment-specific key/values of the Release as a json map
+	EnvParamName = "env"
+
+	// ReleaseParamName is the name of the param holding the namespaced name of the Release the PipelineRun runs for
+	ReleaseParamName = "release"
+
+	// ServiceAccountTokenPath is the path, relative to its workspace, where the bound service account token is mounted
+	ServiceAccountTokenPath = "token"
+
+	// ChainsSignedAnnotation is the annotation set by Tekton Chains once it signs a PipelineRun
+	ChainsSignedAnnotation = "chains.tekton.dev/signed"
+
+	// APIVersionV1 is the Tekton v1 API version
+	APIVersionV1 = "v1"
+
+	// APIVersionV1beta1 is the Tekton v1beta1 API version
+	APIVersionV1beta1 = "v1beta1"
 )
 
 var (
 	// ApplicationNameLabel is the label used to specify the application associated with the PipelineRun
 	ApplicationNameLabel = fmt.Sprintf("%s/%s", appstudioLabelPrefix, "application")
 
+	// ManagedByLabel is the label used to identify the PipelineRuns created by the release service
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+
 	// PipelinesTypeLabel is the label used to describe the type of pipeline
 	PipelinesTypeLabel = fmt.Sprintf("%s/%s", pipelinesLabelPrefix, "type")
 
+	// InputsHashLabel is the label used to specify the hash of the inputs of the PipelineRun, so identical release
+	// PipelineRuns can be found
+	InputsHashLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "inputs-hash")
+
 	// ReleaseNameLabel is the label used to specify the name of the Release associated with the PipelineRun
 	ReleaseNameLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "name")
 
 	// ReleaseNamespaceLabel is the label used to specify the namespace of the Release associated with the PipelineRun
 	ReleaseNamespaceLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "namespace")
+
+	// ReleasePlanNameLabel is the label used to specify the name of the ReleasePlan that produced the PipelineRun
+	ReleasePlanNameLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "release-plan")
+
+	// ReleasePlanNamespaceLabel is the label used to specify the namespace of the ReleasePlan that produced the
+	// PipelineRun
+	ReleasePlanNamespaceLabel = fmt.Sprintf("%s/%s", releaseLabelPrefix, "release-plan-namespace")
+
+	// ReleaseStrategyGenerationAnnotation is the annotation used to specify the generation of the ReleaseStrategy that
+	// produced the PipelineRun
+	ReleaseStrategyGenerationAnnotation = fmt.Sprintf("%s/%s", releaseLabelPrefix, "strategy-generation")
 )
 
 // ReleasePipelineRun is a PipelineRun alias, so we can add new methods to it in this file.
@@ -90,8 +137,16 @@ func (r *ReleasePipelineRun) AsPipelineRun() *tektonv1beta1.PipelineRun {
 }
 
 // WithExtraParam adds an extra param to the release PipelineRun. If the parameter is not part of the Pipeline
-// definition, it will be silently ignored.
+// definition, it will be silently ignored. If the PipelineRun already has a param with the same name, like one set in
+// the PipelineRun template of the ReleaseStrategy, its value is replaced.
 func (r *ReleasePipelineRun) WithExtraParam(name string, value tektonv1beta1.ArrayOrString) *ReleasePipelineRun {
+	for i := range r.Spec.Params {
+		if r.Spec.Params[i].Name == name {
+			r.Spec.Params[i].Value = value
+			return r
+		}
+	}
+
 	r.Spec.Params = append(r.Spec.Params, tektonv1beta1.Param{
 		Name:  name,
 		Value: value,
@@ -119,6 +174,25 @@ func (r *ReleasePipelineRun) WithSnapshot(snapshot *applicationapiv1alpha1.Snaps
 	return r
 }
 
+// WithCollectors adds the given collectors as the tasks of an inline pipeline to the release PipelineRun, marking it as
+// a collectors PipelineRun. The collectors run in parallel.
+func (r *ReleasePipelineRun) WithCollectors(collectors []v1alpha1.Collector) *ReleasePipelineRun {
+	if r.ObjectMeta.Labels == nil {
+		r.ObjectMeta.Labels = map[string]string{}
+	}
+	r.ObjectMeta.Labels[PipelinesTypeLabel] = PipelineTypeCollectors
+
+	r.Spec.PipelineSpec = &tektonv1beta1.PipelineSpec{}
+	for _, collector := range collectors {
+		r.Spec.PipelineSpec.Tasks = append(r.Spec.PipelineSpec.Tasks, tektonv1beta1.PipelineTask{
+			Name:    collector.Name,
+			TaskRef: getTaskRef(collector),
+		})
+	}
+
+	return r
+}
+
 // WithEnterpriseContractPolicy adds a param containing the EnterpriseContractPolicy Spec as a json string to the release PipelineRun.
 func (r *ReleasePipelineRun) WithEnterpriseContractPolicy(enterpriseContractPolicy *ecapiv1alpha1.EnterpriseContractPolicy) *ReleasePipelineRun {
 	policyJson, _ := json.Marshal(enterpriseContractPolicy.Spec)
@@ -134,6 +208,47 @@ func (r *ReleasePipelineRun) WithEnterpriseContractPolicy(enterpriseContractPoli
 	return r
 }
 
+// WithEnv adds a param containing the given key/values as a json map to the release PipelineRun. If the PipelineRun
+// already has an env param holding a json map, like one set by the ReleaseStrategy, both maps are merged and the
+// given values take precedence. Any other existing env param is kept as is. If no key/values are given, nothing is
+// added.
+func (r *ReleasePipelineRun) WithEnv(env map[string]string) *ReleasePipelineRun {
+	if len(env) == 0 {
+		return r
+	}
+
+	for i, param := range r.Spec.Params {
+		if param.Name != EnvParamName {
+			continue
+		}
+
+		existing := map[string]string{}
+		if param.Value.Type != tektonv1beta1.ParamTypeString || json.Unmarshal([]byte(param.Value.StringVal), &existing) != nil {
+			return r
+		}
+
+		merged := make(map[string]string, len(existing)+len(env))
+		for key, value := range existing {
+			merged[key] = value
+		}
+		for key, value := range env {
+			merged[key] = value
+		}
+
+		envJson, _ := json.Marshal(merged)
+		r.Spec.Params[i].Value.StringVal = string(envJson)
+
+		return r
+	}
+
+	envJson, _ := json.Marshal(env)
+
+	return r.WithExtraParam(EnvParamName, tektonv1beta1.ArrayOrString{
+		Type:      tektonv1beta1.ParamTypeString,
+		StringVal: string(envJson),
+	})
+}
+
 // WithOwner set's owner annotations to the release PipelineRun.
 func (r *ReleasePipelineRun) WithOwner(release *v1alpha1.Release) *ReleasePipelineRun {
 	_ = libhandler.SetOwnerAnnotations(release, r)
@@ -141,13 +256,52 @@ func (r *ReleasePipelineRun) WithOwner(release *v1alpha1.Release) *ReleasePipeli
 	return r
 }
 
-// WithReleaseAndApplicationMetadata adds Release and Application metadata to the release PipelineRun.
+// WithPipelineRunTemplate sets the given PipelineRunSpec template as the spec of the release PipelineRun, so the rest
+// of the builder methods are layered onto it. It should be called before any other method setting the spec. If the
+// template is nil, nothing is changed.
+func (r *ReleasePipelineRun) WithPipelineRunTemplate(template *runtime.RawExtension) *ReleasePipelineRun {
+	if template == nil || len(template.Raw) == 0 {
+		return r
+	}
+
+	// The template is validated when the ReleaseStrategy is admitted or resolved from its artifact, so no error should
+	// be raised when unmarshalling it. If one is, the template is ignored and the PipelineRun is built from an empty spec.
+	spec := tektonv1beta1.PipelineRunSpec{}
+	if err := json.Unmarshal(template.Raw, &spec); err == nil {
+		r.Spec = spec
+	}
+
+	return r
+}
+
+// WithPropagatedMetadata copies the labels and annotations of the given Release whose names start with any of the given
+// prefixes to the release PipelineRun, so it can be attributed and traced back to the Release. Metadata already set in
+// the PipelineRun is never overwritten and empty prefixes are ignored, so the Release can't replace the metadata the
+// release PipelineRun needs.
+func (r *ReleasePipelineRun) WithPropagatedMetadata(release *v1alpha1.Release, prefixes []string) *ReleasePipelineRun {
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			continue
+		}
+
+		metadata.AddAnnotations(r.AsPipelineRun(), metadata.GetAnnotationsWithPrefix(release, prefix))
+		metadata.AddLabels(r.AsPipelineRun(), metadata.GetLabelsWithPrefix(release, prefix))
+	}
+
+	return r
+}
+
+// WithReleaseAndApplicationMetadata adds Release and Application metadata to the release PipelineRun. The ReleasePlan
+// used by the Release is also added, so PipelineRuns can be traced back to the ReleasePlan that produced them.
 func (r *ReleasePipelineRun) WithReleaseAndApplicationMetadata(release *v1alpha1.Release, applicationName string) *ReleasePipelineRun {
 	r.ObjectMeta.Labels = map[string]string{
-		PipelinesTypeLabel:    PipelineTypeRelease,
-		ReleaseNameLabel:      release.Name,
-		ReleaseNamespaceLabel: release.Namespace,
-		ApplicationNameLabel:  applicationName,
+		ManagedByLabel:            ManagedByLabelValue,
+		PipelinesTypeLabel:        PipelineTypeRelease,
+		ReleaseNameLabel:          release.Name,
+		ReleaseNamespaceLabel:     release.Namespace,
+		ReleasePlanNameLabel:      metadata.SanitizeLabelValue(release.Spec.ReleasePlan),
+		ReleasePlanNamespaceLabel: release.Namespace,
+		ApplicationNameLabel:      applicationName,
 	}
 	metadata.AddAnnotations(r.AsPipelineRun(), metadata.GetAnnotationsWithPrefix(release, integrationServiceGitopsPkg.PipelinesAsCodePrefix))
 	metadata.AddLabels(r.AsPipelineRun(), metadata.GetLabelsWithPrefix(release, integrationServiceGitopsPkg.PipelinesAsCodePrefix))
@@ -155,13 +309,39 @@ func (r *ReleasePipelineRun) WithReleaseAndApplicationMetadata(release *v1alpha1
 	return r
 }
 
-// WithReleaseStrategy adds Pipeline reference and parameters to the release PipelineRun.
+// WithReleaseParam adds a param containing the namespaced name of the given Release to the release PipelineRun, so the
+// release Pipeline knows which Release it runs for. If the PipelineRun already has a release param, like one set by
+// the ReleaseStrategy, it's kept as is.
+func (r *ReleasePipelineRun) WithReleaseParam(release *v1alpha1.Release) *ReleasePipelineRun {
+	for _, param := range r.Spec.Params {
+		if param.Name == ReleaseParamName {
+			return r
+		}
+	}
+
+	return r.WithExtraParam(ReleaseParamName, tektonv1beta1.ArrayOrString{
+		Type:      tektonv1beta1.ParamTypeString,
+		StringVal: fmt.Sprintf("%s%c%s", release.Namespace, types.Separator, release.Name),
+	})
+}
+
+// WithReleaseStrategy adds Pipeline reference and parameters to the release PipelineRun. If the strategy has a
+// PipelineRun template, it's used as the base of the spec, so the values set by the strategy take precedence over it.
+// The generation of the strategy is recorded in the strategy-generation annotation. The workspaces declared in the
+// strategy are bound after the default one, so they replace it if they have the same name.
 func (r *ReleasePipelineRun) WithReleaseStrategy(strategy *v1alpha1.ReleaseStrategy) *ReleasePipelineRun {
+	r.WithPipelineRunTemplate(strategy.Spec.PipelineRunTemplate)
 	r.Spec.PipelineRef = getPipelineRef(strategy)
 
-	valueType := tektonv1beta1.ParamTypeString
+	// ReleaseStrategies resolved from artifacts aren't stored in the cluster, so they have no generation to record
+	if strategy.Generation > 0 {
+		metadata.AddAnnotations(r.AsPipelineRun(), map[string]string{
+			ReleaseStrategyGenerationAnnotation: strconv.FormatInt(strategy.Generation, 10),
+		})
+	}
 
 	for _, param := range strategy.Spec.Params {
+		valueType := tektonv1beta1.ParamTypeString
 		if len(param.Values) > 0 {
 			valueType = tektonv1beta1.ParamTypeArray
 		}
@@ -179,11 +359,49 @@ func (r *ReleasePipelineRun) WithReleaseStrategy(strategy *v1alpha1.ReleaseStrat
 		r.WithWorkspace(os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"), strategy.Spec.PersistentVolumeClaim)
 	}
 
-	r.WithServiceAccount(strategy.Spec.ServiceAccount)
+	for _, workspace := range strategy.Spec.Workspaces {
+		r.withStrategyWorkspace(workspace)
+	}
+
+	if strategy.Spec.ServiceAccount != "" {
+		r.WithServiceAccount(strategy.Spec.ServiceAccount)
+	} else if r.Spec.ServiceAccountName == "" {
+		r.WithServiceAccount(os.Getenv("DEFAULT_RELEASE_SERVICE_ACCOUNT"))
+	}
+
+	r.WithServiceAccountToken(strategy.Spec.ServiceAccountToken)
+
+	if strategy.Spec.Timeout != nil {
+		r.WithTimeout(strategy.Spec.Timeout.Duration)
+	}
 
 	return r
 }
 
+// WithServiceAccountToken adds a workspace to the PipelineRun where a bound token of its service account is mounted.
+// The workspace is backed by a projected volume, so the token is minted by the kubelet when the PipelineRun pods start
+// and only a reference to it is stored in the PipelineRun. If the given token is nil, no workspace will be added.
+func (r *ReleasePipelineRun) WithServiceAccountToken(token *v1alpha1.ServiceAccountToken) *ReleasePipelineRun {
+	if token == nil {
+		return r
+	}
+
+	return r.withWorkspaceBinding(tektonv1beta1.WorkspaceBinding{
+		Name: token.Workspace,
+		Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{
+				{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          token.Audience,
+						ExpirationSeconds: token.ExpirationSeconds,
+						Path:              ServiceAccountTokenPath,
+					},
+				},
+			},
+		},
+	})
+}
+
 // WithServiceAccount adds a reference to the service account to be used to gain elevated privileges during the
 // execution of the different Pipeline tasks.
 func (r *ReleasePipelineRun) WithServiceAccount(serviceAccount string) *ReleasePipelineRun {
@@ -192,6 +410,47 @@ func (r *ReleasePipelineRun) WithServiceAccount(serviceAccount string) *ReleaseP
 	return r
 }
 
+// WithTaskServiceAccounts adds a TaskRun spec to the PipelineRun for each of the given tasks, so their TaskRuns run
+// with the service account they are mapped to. Tasks that already have a TaskRun spec get their service account
+// replaced instead. Tasks mapped to an empty service account are ignored.
+func (r *ReleasePipelineRun) WithTaskServiceAccounts(serviceAccounts map[string]string) *ReleasePipelineRun {
+	tasks := make([]string, 0, len(serviceAccounts))
+	for task, serviceAccount := range serviceAccounts {
+		if serviceAccount != "" {
+			tasks = append(tasks, task)
+		}
+	}
+	sort.Strings(tasks)
+
+tasks:
+	for _, task := range tasks {
+		for i := range r.Spec.TaskRunSpecs {
+			if r.Spec.TaskRunSpecs[i].PipelineTaskName == task {
+				r.Spec.TaskRunSpecs[i].TaskServiceAccountName = serviceAccounts[task]
+				continue tasks
+			}
+		}
+
+		r.Spec.TaskRunSpecs = append(r.Spec.TaskRunSpecs, tektonv1beta1.PipelineTaskRunSpec{
+			PipelineTaskName:       task,
+			TaskServiceAccountName: serviceAccounts[task],
+		})
+	}
+
+	return r
+}
+
+// WithTimeout sets the maximum time the whole PipelineRun can run for, including its finally tasks. Any tasks or
+// finally timeout already set is kept.
+func (r *ReleasePipelineRun) WithTimeout(timeout time.Duration) *ReleasePipelineRun {
+	if r.Spec.Timeouts == nil {
+		r.Spec.Timeouts = &tektonv1beta1.TimeoutFields{}
+	}
+	r.Spec.Timeouts.Pipeline = &v1.Duration{Duration: timeout}
+
+	return r
+}
+
 // WithWorkspace adds a workspace to the PipelineRun using the given name and PersistentVolumeClaim.
 // If any of those values is empty, no workspace will be added.
 func (r *ReleasePipelineRun) WithWorkspace(name, persistentVolumeClaim string) *ReleasePipelineRun {
@@ -199,12 +458,38 @@ func (r *ReleasePipelineRun) WithWorkspace(name, persistentVolumeClaim string) *
 		return r
 	}
 
-	r.Spec.Workspaces = append(r.Spec.Workspaces, tektonv1beta1.WorkspaceBinding{
+	return r.withWorkspaceBinding(tektonv1beta1.WorkspaceBinding{
 		Name: name,
 		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 			ClaimName: persistentVolumeClaim,
 		},
 	})
+}
+
+// withStrategyWorkspace adds a workspace to the PipelineRun bound to the existing pvc or to a pvc created from the
+// template set in the given ReleaseStrategy workspace. If the workspace sets neither, no workspace will be added.
+func (r *ReleasePipelineRun) withStrategyWorkspace(workspace v1alpha1.Workspace) *ReleasePipelineRun {
+	if workspace.VolumeClaimTemplate != nil {
+		return r.withWorkspaceBinding(tektonv1beta1.WorkspaceBinding{
+			Name:                workspace.Name,
+			VolumeClaimTemplate: workspace.VolumeClaimTemplate.DeepCopy(),
+		})
+	}
+
+	return r.WithWorkspace(workspace.Name, workspace.PersistentVolumeClaim)
+}
+
+// withWorkspaceBinding adds the given workspace binding to the PipelineRun. If the PipelineRun already has a workspace
+// with the same name, like one set in the PipelineRun template of the ReleaseStrategy, it's replaced.
+func (r *ReleasePipelineRun) withWorkspaceBinding(binding tektonv1beta1.WorkspaceBinding) *ReleasePipelineRun {
+	for i := range r.Spec.Workspaces {
+		if r.Spec.Workspaces[i].Name == binding.Name {
+			r.Spec.Workspaces[i] = binding
+			return r
+		}
+	}
+
+	r.Spec.Workspaces = append(r.Spec.Workspaces, binding)
 
 	return r
 }
@@ -218,12 +503,26 @@ func getPipelineRef(strategy *v1alpha1.ReleaseStrategy) *tektonv1beta1.PipelineR
 	}
 
 	return &tektonv1beta1.PipelineRef{
-		ResolverRef: getBundleResolver(strategy.Spec.Bundle, strategy.Spec.Pipeline),
+		ResolverRef: getBundleResolver(strategy.Spec.Bundle, "pipeline", strategy.Spec.Pipeline),
+	}
+}
+
+// getTaskRef returns a TaskRef for the given collector. If the collector references a bundle, the TaskRef will use
+// the bundles resolver.
+func getTaskRef(collector v1alpha1.Collector) *tektonv1beta1.TaskRef {
+	if collector.Bundle == "" {
+		return &tektonv1beta1.TaskRef{
+			Name: collector.Task,
+		}
+	}
+
+	return &tektonv1beta1.TaskRef{
+		ResolverRef: getBundleResolver(collector.Bundle, "task", collector.Task),
 	}
 }
 
-// getBundleResolver returns a bundle ResolverRef for the given bundle and pipeline.
-func getBundleResolver(bundle, pipeline string) tektonv1beta1.ResolverRef {
+// getBundleResolver returns a bundle ResolverRef for the resource of the given kind and name in the given bundle.
+func getBundleResolver(bundle, kind, name string) tektonv1beta1.ResolverRef {
 	return tektonv1beta1.ResolverRef{
 		Resolver: "bundles",
 		Params: []tektonv1beta1.Param{
@@ -238,14 +537,14 @@ func getBundleResolver(bundle, pipeline string) tektonv1beta1.ResolverRef {
 				Name: "kind",
 				Value: tektonv1beta1.ParamValue{
 					Type:      tektonv1beta1.ParamTypeString,
-					StringVal: "pipeline",
+					StringVal: kind,
 				},
 			},
 			{
 				Name: "name",
 				Value: tektonv1beta1.ParamValue{
 					Type:      tektonv1beta1.ParamTypeString,
-					StringVal: pipeline,
+					StringVal: name,
 				},
 			},
 		},
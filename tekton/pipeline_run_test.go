@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"os"
 	"reflect"
+	"time"
 
 	ecapiv1alpha1 "github.com/enterprise-contract/enterprise-contract-controller/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
@@ -31,7 +32,9 @@ import (
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
 
 	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type ExtraParams struct {
@@ -178,14 +181,52 @@ var _ = Describe("PipelineRun", func() {
 
 		It("can append the release Name, Namespace, and Application to a ReleasePipelineRun object and that these label key names match the correct label format", func() {
 			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName)
+			Expect(releasePipelineRun.Labels[ManagedByLabel]).To(Equal(ManagedByLabelValue))
 			Expect(releasePipelineRun.Labels["release.appstudio.openshift.io/name"]).
 				To(Equal(release.Name))
 			Expect(releasePipelineRun.Labels["release.appstudio.openshift.io/namespace"]).
 				To(Equal(release.Namespace))
+			Expect(releasePipelineRun.Labels["release.appstudio.openshift.io/release-plan"]).
+				To(Equal(release.Spec.ReleasePlan))
+			Expect(releasePipelineRun.Labels["release.appstudio.openshift.io/release-plan-namespace"]).
+				To(Equal(release.Namespace))
 			Expect(releasePipelineRun.Labels["appstudio.openshift.io/application"]).
 				To(Equal(applicationName))
 		})
 
+		It("can copy the Release labels and annotations matching the given prefixes to the PipelineRun", func() {
+			labeledRelease := release.DeepCopy()
+			labeledRelease.Labels = map[string]string{
+				"build.appstudio.openshift.io/cost-center": "42",
+				"team":           "payments",
+				ReleaseNameLabel: "spoofed",
+			}
+			labeledRelease.Annotations = map[string]string{
+				"build.appstudio.openshift.io/repo": "https://example.com/repo",
+				"traceparent":                       "00-trace-span-01",
+				"unrelated":                         "value",
+			}
+
+			releasePipelineRun.WithReleaseAndApplicationMetadata(labeledRelease, applicationName)
+			releasePipelineRun.WithPropagatedMetadata(labeledRelease,
+				[]string{"build.appstudio.openshift.io/", "traceparent", "", ReleaseNameLabel})
+			Expect(releasePipelineRun.Labels).To(HaveKeyWithValue("build.appstudio.openshift.io/cost-center", "42"))
+			Expect(releasePipelineRun.Labels).To(HaveKeyWithValue(ReleaseNameLabel, labeledRelease.Name))
+			Expect(releasePipelineRun.Labels).NotTo(HaveKey("team"))
+			Expect(releasePipelineRun.Annotations).To(HaveKeyWithValue("build.appstudio.openshift.io/repo", "https://example.com/repo"))
+			Expect(releasePipelineRun.Annotations).To(HaveKeyWithValue("traceparent", "00-trace-span-01"))
+			Expect(releasePipelineRun.Annotations).NotTo(HaveKey("unrelated"))
+		})
+
+		It("should not copy any Release metadata to the PipelineRun if no prefixes are given", func() {
+			labeledRelease := release.DeepCopy()
+			labeledRelease.Labels = map[string]string{"team": "payments"}
+
+			releasePipelineRun.WithPropagatedMetadata(labeledRelease, nil)
+			Expect(releasePipelineRun.Labels).To(BeEmpty())
+			Expect(releasePipelineRun.Annotations).To(BeEmpty())
+		})
+
 		It("can return a PipelineRun object from a ReleasePipelineRun object", func() {
 			Expect(reflect.TypeOf(releasePipelineRun.AsPipelineRun())).
 				To(Equal(reflect.TypeOf(&tektonv1beta1.PipelineRun{})))
@@ -221,17 +262,212 @@ var _ = Describe("PipelineRun", func() {
 			Expect(releasePipelineRun.Spec.PipelineRef.ResolverRef.Params[2].Value.StringVal).To(Equal(strategy.Spec.Pipeline))
 		})
 
+		It("records the generation of the ReleaseStrategy in an annotation", func() {
+			strategy.Generation = 3
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Annotations).To(HaveKeyWithValue(ReleaseStrategyGenerationAnnotation, "3"))
+		})
+
+		It("doesn't record the generation of a ReleaseStrategy without one", func() {
+			strategy.Generation = 0
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Annotations).NotTo(HaveKey(ReleaseStrategyGenerationAnnotation))
+		})
+
+		It("passes the ReleaseStrategy params to the PipelineRun in the same order", func() {
+			strategy.Spec.Params = []v1alpha1.Params{
+				{Name: "images", Values: []string{"quay.io/a", "quay.io/b"}},
+				{Name: "region", Value: "us-east-1"},
+				{Name: "tier", Value: "prod"},
+			}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Params).To(Equal([]tektonv1beta1.Param{
+				{Name: "images", Value: tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeArray, ArrayVal: []string{"quay.io/a", "quay.io/b"}}},
+				{Name: "region", Value: tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeString, StringVal: "us-east-1"}},
+				{Name: "tier", Value: tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeString, StringVal: "prod"}},
+			}))
+		})
+
+		It("should not add any params if the ReleaseStrategy doesn't have any", func() {
+			strategy.Spec.Params = nil
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("can add the namespaced name of the Release as the release param", func() {
+			releasePipelineRun.WithReleaseParam(release)
+			Expect(releasePipelineRun.Spec.Params).To(ContainElement(tektonv1beta1.Param{
+				Name:  ReleaseParamName,
+				Value: tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeString, StringVal: release.Namespace + "/" + release.Name},
+			}))
+		})
+
+		It("keeps the release param set by the ReleaseStrategy", func() {
+			strategy.Spec.Params = []v1alpha1.Params{{Name: ReleaseParamName, Value: "custom"}}
+			releasePipelineRun.WithReleaseStrategy(strategy).WithReleaseParam(release)
+			Expect(releasePipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(releasePipelineRun.Spec.Params[0].Value.StringVal).To(Equal("custom"))
+		})
+
+		It("can add the collectors as the tasks of an inline pipeline to a PipelineRun object", func() {
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName).
+				WithCollectors([]v1alpha1.Collector{
+					{Name: "collect-sbom", Task: "sbom"},
+					{Name: "collect-digest", Task: "digest", Bundle: "quay.io/org/bundle:tag"},
+				})
+			Expect(releasePipelineRun.Labels).To(HaveKeyWithValue(PipelinesTypeLabel, PipelineTypeCollectors))
+			Expect(releasePipelineRun.Labels).To(HaveKeyWithValue(ReleaseNameLabel, release.Name))
+			Expect(releasePipelineRun.Spec.PipelineRef).To(BeNil())
+			Expect(releasePipelineRun.Spec.PipelineSpec).NotTo(BeNil())
+			Expect(releasePipelineRun.Spec.PipelineSpec.Tasks).To(HaveLen(2))
+			Expect(releasePipelineRun.Spec.PipelineSpec.Tasks[0].Name).To(Equal("collect-sbom"))
+			Expect(releasePipelineRun.Spec.PipelineSpec.Tasks[0].TaskRef.Name).To(Equal("sbom"))
+			Expect(releasePipelineRun.Spec.PipelineSpec.Tasks[1].Name).To(Equal("collect-digest"))
+			Expect(releasePipelineRun.Spec.PipelineSpec.Tasks[1].TaskRef.ResolverRef.Resolver).
+				To(Equal(tektonv1beta1.ResolverName("bundles")))
+		})
+
 		It("can add the reference to the service account that should be used", func() {
 			releasePipelineRun.WithServiceAccount(serviceAccountName)
 			Expect(releasePipelineRun.Spec.ServiceAccountName).To(Equal(serviceAccountName))
 		})
 
+		It("can set the service accounts of the given tasks", func() {
+			releasePipelineRun.WithTaskServiceAccounts(map[string]string{
+				"sign":  "signer",
+				"push":  "registry-pusher",
+				"empty": "",
+			})
+			Expect(releasePipelineRun.Spec.TaskRunSpecs).To(Equal([]tektonv1beta1.PipelineTaskRunSpec{
+				{PipelineTaskName: "push", TaskServiceAccountName: "registry-pusher"},
+				{PipelineTaskName: "sign", TaskServiceAccountName: "signer"},
+			}))
+		})
+
 		It("can add a workspace to the PipelineRun using the given name and PVC", func() {
 			releasePipelineRun.WithWorkspace(workspace, persistentVolumeClaim)
 			Expect(releasePipelineRun.Spec.Workspaces).Should(ContainElement(HaveField("Name", Equal(workspace))))
 			Expect(releasePipelineRun.Spec.Workspaces).Should(ContainElement(HaveField("PersistentVolumeClaim.ClaimName", Equal(persistentVolumeClaim))))
 		})
 
+		It("can add the ReleaseStrategy workspaces backed by a pvc template", func() {
+			template := &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				},
+			}
+			strategy.Spec.Workspaces = []v1alpha1.Workspace{{Name: "signing", VolumeClaimTemplate: template}}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Workspaces).To(ContainElement(tektonv1beta1.WorkspaceBinding{
+				Name:                "signing",
+				VolumeClaimTemplate: template,
+			}))
+		})
+
+		It("can add the ReleaseStrategy workspaces backed by an existing pvc", func() {
+			strategy.Spec.Workspaces = []v1alpha1.Workspace{{Name: "signing", PersistentVolumeClaim: "signing-pvc"}}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Workspaces).To(ContainElement(tektonv1beta1.WorkspaceBinding{
+				Name:                  "signing",
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "signing-pvc"},
+			}))
+		})
+
+		It("can add a workspace with a bound service account token to the PipelineRun", func() {
+			expirationSeconds := int64(3600)
+			releasePipelineRun.WithServiceAccountToken(&v1alpha1.ServiceAccountToken{
+				Workspace:         "registry-token",
+				Audience:          "registry",
+				ExpirationSeconds: &expirationSeconds,
+			})
+			Expect(releasePipelineRun.Spec.Workspaces).To(HaveLen(1))
+			tokenWorkspace := releasePipelineRun.Spec.Workspaces[0]
+			Expect(tokenWorkspace.Name).To(Equal("registry-token"))
+			Expect(tokenWorkspace.Secret).To(BeNil())
+			Expect(tokenWorkspace.Projected).NotTo(BeNil())
+			Expect(tokenWorkspace.Projected.Sources).To(HaveLen(1))
+			Expect(tokenWorkspace.Projected.Sources[0].ServiceAccountToken).To(Equal(&corev1.ServiceAccountTokenProjection{
+				Audience:          "registry",
+				ExpirationSeconds: &expirationSeconds,
+				Path:              ServiceAccountTokenPath,
+			}))
+			Expect(releasePipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("should not add a workspace if no service account token is given", func() {
+			releasePipelineRun.WithServiceAccountToken(nil)
+			Expect(releasePipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+
+		It("can add the ReleaseStrategy service account token to the PipelineRun", func() {
+			strategy.Spec.ServiceAccountToken = &v1alpha1.ServiceAccountToken{Workspace: "registry-token"}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Workspaces).To(ContainElement(HaveField("Name", Equal("registry-token"))))
+			Expect(releasePipelineRun.Spec.Workspaces).To(ContainElement(
+				HaveField("Projected.Sources", ContainElement(HaveField("ServiceAccountToken.Path", Equal(ServiceAccountTokenPath))))))
+		})
+
+		It("can set the timeout of the whole PipelineRun", func() {
+			releasePipelineRun.WithTimeout(time.Hour)
+			Expect(releasePipelineRun.Spec.Timeouts.Pipeline.Duration).To(Equal(time.Hour))
+		})
+
+		It("can add the ReleaseStrategy timeout to the PipelineRun", func() {
+			strategy.Spec.Timeout = &metav1.Duration{Duration: 30 * time.Minute}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Timeouts.Pipeline.Duration).To(Equal(30 * time.Minute))
+		})
+
+		It("should not set a timeout if the ReleaseStrategy doesn't set one", func() {
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.Timeouts).To(BeNil())
+		})
+
+		It("can add the env as a json map to the PipelineRun", func() {
+			releasePipelineRun.WithEnv(map[string]string{"region": "us-east-1", "tier": "prod"})
+			Expect(releasePipelineRun.Spec.Params).To(Equal([]tektonv1beta1.Param{
+				{
+					Name: EnvParamName,
+					Value: tektonv1beta1.ArrayOrString{
+						Type:      tektonv1beta1.ParamTypeString,
+						StringVal: `{"region":"us-east-1","tier":"prod"}`,
+					},
+				},
+			}))
+		})
+
+		It("should not add an env param if no env is given", func() {
+			releasePipelineRun.WithEnv(nil)
+			Expect(releasePipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("merges the env with the env param of the ReleaseStrategy, keeping the given values", func() {
+			strategy.Spec.Params = []v1alpha1.Params{
+				{Name: EnvParamName, Value: `{"region": "eu-west-1", "cluster": "managed"}`},
+			}
+			releasePipelineRun.WithReleaseStrategy(strategy).
+				WithEnv(map[string]string{"region": "us-east-1", "tier": "prod"})
+
+			var envParams []tektonv1beta1.Param
+			for _, param := range releasePipelineRun.Spec.Params {
+				if param.Name == EnvParamName {
+					envParams = append(envParams, param)
+				}
+			}
+			Expect(envParams).To(HaveLen(1))
+			Expect(envParams[0].Value.StringVal).To(Equal(`{"cluster":"managed","region":"us-east-1","tier":"prod"}`))
+		})
+
+		It("keeps an existing env param that isn't a json map", func() {
+			releasePipelineRun.WithExtraParam(EnvParamName, tektonv1beta1.ArrayOrString{
+				Type:      tektonv1beta1.ParamTypeString,
+				StringVal: "production",
+			})
+			releasePipelineRun.WithEnv(map[string]string{"tier": "prod"})
+			Expect(releasePipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(releasePipelineRun.Spec.Params[0].Value.StringVal).To(Equal("production"))
+		})
+
 		It("can add an EnterpriseContractPolicy to the PipelineRun", func() {
 			releasePipelineRun.WithEnterpriseContractPolicy(enterpriseContractPolicy)
 			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
@@ -270,6 +506,114 @@ var _ = Describe("PipelineRun", func() {
 		})
 	})
 
+	Context("WithReleaseStrategy handles all limbs of service account conditional branch", func() {
+		AfterEach(func() {
+			os.Unsetenv("DEFAULT_RELEASE_SERVICE_ACCOUNT")
+		})
+
+		When("strategy.Spec.ServiceAccount is empty", func() {
+			It("sets the service account from the DEFAULT_RELEASE_SERVICE_ACCOUNT environment variable", func() {
+				os.Setenv("DEFAULT_RELEASE_SERVICE_ACCOUNT", "default-service-account")
+				strategy.Spec.ServiceAccount = ""
+				releasePipelineRun.WithReleaseStrategy(strategy)
+				Expect(releasePipelineRun.Spec.ServiceAccountName).To(Equal("default-service-account"))
+			})
+
+			It("leaves the service account unset if no default service account is configured", func() {
+				strategy.Spec.ServiceAccount = ""
+				releasePipelineRun.WithReleaseStrategy(strategy)
+				Expect(releasePipelineRun.Spec.ServiceAccountName).To(BeEmpty())
+			})
+		})
+		When("strategy.Spec.ServiceAccount is set", func() {
+			It("uses the service account of the strategy over the DEFAULT_RELEASE_SERVICE_ACCOUNT environment variable", func() {
+				os.Setenv("DEFAULT_RELEASE_SERVICE_ACCOUNT", "default-service-account")
+				releasePipelineRun.WithReleaseStrategy(strategy)
+				Expect(releasePipelineRun.Spec.ServiceAccountName).To(Equal(serviceAccountName))
+			})
+		})
+	})
+
+	Context("When using a PipelineRun template", func() {
+		AfterEach(func() {
+			os.Unsetenv("DEFAULT_RELEASE_WORKSPACE_NAME")
+			os.Unsetenv("DEFAULT_RELEASE_SERVICE_ACCOUNT")
+		})
+
+		It("replaces the value of an existing param with the same name", func() {
+			releasePipelineRun.WithExtraParam("foo", tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeString, StringVal: "bar"})
+			releasePipelineRun.WithExtraParam("foo", tektonv1beta1.ArrayOrString{Type: tektonv1beta1.ParamTypeString, StringVal: "baz"})
+			Expect(releasePipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(releasePipelineRun.Spec.Params[0].Value.StringVal).To(Equal("baz"))
+		})
+
+		It("leaves the spec unchanged if there is no template", func() {
+			releasePipelineRun.WithServiceAccount(serviceAccountName)
+			releasePipelineRun.WithPipelineRunTemplate(nil)
+			Expect(releasePipelineRun.Spec.ServiceAccountName).To(Equal(serviceAccountName))
+		})
+
+		It("layers the strategy and the Release onto the template", func() {
+			os.Setenv("DEFAULT_RELEASE_WORKSPACE_NAME", workspace)
+			os.Setenv("DEFAULT_RELEASE_SERVICE_ACCOUNT", "default-service-account")
+			strategy.Spec.ServiceAccount = ""
+			strategy.Spec.Timeout = &metav1.Duration{Duration: time.Hour}
+			strategy.Spec.PipelineRunTemplate = &runtime.RawExtension{Raw: []byte(`{
+				"serviceAccountName": "template-service-account",
+				"params": [
+					{"name": "testparam1", "value": "template-value"},
+					{"name": "template-param", "value": "template-value"}
+				],
+				"workspaces": [
+					{"name": "test-workspace", "emptyDir": {}},
+					{"name": "template-workspace", "emptyDir": {}}
+				],
+				"taskRunSpecs": [
+					{"pipelineTaskName": "push", "taskServiceAccountName": "template-pusher"}
+				],
+				"timeouts": {"tasks": "30m"},
+				"podTemplate": {"nodeSelector": {"release": "true"}}
+			}`)}
+
+			releasePipelineRun.WithReleaseStrategy(strategy).
+				WithTaskServiceAccounts(map[string]string{"push": "registry-pusher"}).
+				WithSnapshot(snapshot)
+
+			spec := releasePipelineRun.Spec
+			Expect(spec.PipelineRef).To(Equal(getPipelineRef(strategy)))
+			Expect(spec.ServiceAccountName).To(Equal("template-service-account"))
+			Expect(spec.PodTemplate.NodeSelector).To(HaveKeyWithValue("release", "true"))
+
+			// Template params come first and are replaced by the strategy params with the same name
+			Expect(spec.Params).To(HaveLen(3))
+			Expect(spec.Params[0].Name).To(Equal("testparam1"))
+			Expect(spec.Params[0].Value.ArrayVal).To(Equal([]string{"val1", "val2"}))
+			Expect(spec.Params[1].Name).To(Equal("template-param"))
+			Expect(spec.Params[1].Value.StringVal).To(Equal("template-value"))
+			Expect(spec.Params[2].Name).To(Equal("snapshot"))
+
+			// The release workspace replaces the template one with the same name
+			Expect(spec.Workspaces).To(HaveLen(2))
+			Expect(spec.Workspaces[0].Name).To(Equal(workspace))
+			Expect(spec.Workspaces[0].EmptyDir).To(BeNil())
+			Expect(spec.Workspaces[0].PersistentVolumeClaim.ClaimName).To(Equal(persistentVolumeClaim))
+			Expect(spec.Workspaces[1].Name).To(Equal("template-workspace"))
+
+			Expect(spec.TaskRunSpecs).To(Equal([]tektonv1beta1.PipelineTaskRunSpec{
+				{PipelineTaskName: "push", TaskServiceAccountName: "registry-pusher"},
+			}))
+
+			Expect(spec.Timeouts.Pipeline.Duration).To(Equal(time.Hour))
+			Expect(spec.Timeouts.Tasks.Duration).To(Equal(30 * time.Minute))
+		})
+
+		It("uses the service account of the strategy over the one of the template", func() {
+			strategy.Spec.PipelineRunTemplate = &runtime.RawExtension{Raw: []byte(`{"serviceAccountName": "template-service-account"}`)}
+			releasePipelineRun.WithReleaseStrategy(strategy)
+			Expect(releasePipelineRun.Spec.ServiceAccountName).To(Equal(serviceAccountName))
+		})
+	})
+
 	Context("When calling getPipelineRef", func() {
 		It("should return a PipelineRef without resolver if the releaseStrategy does not contain a bundle", func() {
 			releaseStrategy := &v1alpha1.ReleaseStrategy{
@@ -302,7 +646,7 @@ var _ = Describe("PipelineRun", func() {
 
 	Context("When calling getBundleResolver", func() {
 		It("should return a bundle resolver referencing the releaseStrategy Bundle and Pipeline", func() {
-			bundleResolver := getBundleResolver(strategy.Spec.Bundle, strategy.Spec.Pipeline)
+			bundleResolver := getBundleResolver(strategy.Spec.Bundle, "pipeline", strategy.Spec.Pipeline)
 			Expect(bundleResolver).NotTo(Equal(tektonv1beta1.ResolverRef{}))
 			Expect(bundleResolver.Resolver).To(Equal(tektonv1beta1.ResolverName("bundles")))
 			Expect(bundleResolver.Params).To(HaveLen(3))
@@ -314,4 +658,21 @@ var _ = Describe("PipelineRun", func() {
 			Expect(bundleResolver.Params[2].Value.StringVal).To(Equal(strategy.Spec.Pipeline))
 		})
 	})
+
+	Context("When calling getTaskRef", func() {
+		It("should return a TaskRef without resolver if the collector does not contain a bundle", func() {
+			taskRef := getTaskRef(v1alpha1.Collector{Name: "collect", Task: "task"})
+			Expect(taskRef.Name).To(Equal("task"))
+			Expect(taskRef.ResolverRef).To(Equal(tektonv1beta1.ResolverRef{}))
+		})
+
+		It("should return a TaskRef with a bundle resolver if the collector contains a bundle", func() {
+			taskRef := getTaskRef(v1alpha1.Collector{Name: "collect", Task: "task", Bundle: "quay.io/org/bundle:tag"})
+			Expect(taskRef.Name).To(BeEmpty())
+			Expect(taskRef.ResolverRef.Resolver).To(Equal(tektonv1beta1.ResolverName("bundles")))
+			Expect(taskRef.ResolverRef.Params[0].Value.StringVal).To(Equal("quay.io/org/bundle:tag"))
+			Expect(taskRef.ResolverRef.Params[1].Value.StringVal).To(Equal("task"))
+			Expect(taskRef.ResolverRef.Params[2].Value.StringVal).To(Equal("task"))
+		})
+	})
 })
@@ -22,7 +22,8 @@ import (
 )
 
 // ReleasePipelineRunSucceededPredicate returns a predicate which filters out all objects except
-// release PipelineRuns which have just succeeded.
+// release PipelineRuns which have just succeeded, whose status message changed or whose spec.status or referenced
+// pipeline have been changed externally and collectors PipelineRuns which have just finished.
 func ReleasePipelineRunSucceededPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(createEvent event.CreateEvent) bool {
@@ -35,7 +36,10 @@ func ReleasePipelineRunSucceededPredicate() predicate.Predicate {
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return isReleasePipelineRun(e.ObjectNew) && hasPipelineSucceeded(e.ObjectNew)
+			return (isReleasePipelineRun(e.ObjectNew) &&
+				(hasPipelineSucceeded(e.ObjectNew) || hasSpecStatusChanged(e.ObjectOld, e.ObjectNew) ||
+					hasPipelineRefChanged(e.ObjectOld, e.ObjectNew) || hasStatusMessageChanged(e.ObjectOld, e.ObjectNew))) ||
+				(isCollectorsPipelineRun(e.ObjectNew) && hasPipelineSucceeded(e.ObjectNew))
 		},
 	}
 }
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -122,5 +123,57 @@ var _ = Describe("Predicates", func() {
 			releasePipelineRun.Status.MarkSucceeded("Predicate function tests", "Set it to Succeeded")
 			Expect(instance.Update(contextEvent)).To(BeTrue())
 		})
+
+		It("should return true when an updated event is received for a release PipelineRun pointed at another pipeline", func() {
+			releasePipelineRun.AsPipelineRun().Status.InitializeConditions(clock.RealClock{})
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName)
+			releasePipelineRun.Spec.PipelineRef = &tektonv1beta1.PipelineRef{Name: "release-pipeline"}
+			tamperedPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			tamperedPipelineRun.Spec.PipelineRef.Name = "other-pipeline"
+			contextEvent := event.UpdateEvent{
+				ObjectOld: releasePipelineRun.AsPipelineRun(),
+				ObjectNew: tamperedPipelineRun,
+			}
+			Expect(instance.Update(contextEvent)).To(BeTrue())
+		})
+
+		It("should return true when an updated event is received for a release PipelineRun whose status message changed", func() {
+			releasePipelineRun.AsPipelineRun().Status.InitializeConditions(clock.RealClock{})
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName)
+			releasePipelineRun.Status.MarkRunning("Running", "Tasks Completed: 1 (Failed: 0, Cancelled 0), Incomplete: 2, Skipped: 0")
+			progressedPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			contextEvent := event.UpdateEvent{
+				ObjectOld: releasePipelineRun.AsPipelineRun(),
+				ObjectNew: progressedPipelineRun,
+			}
+			Expect(instance.Update(contextEvent)).To(BeFalse())
+			progressedPipelineRun.Status.MarkRunning("Running", "Tasks Completed: 2 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0")
+			Expect(instance.Update(contextEvent)).To(BeTrue())
+		})
+
+		It("should return true when an updated event is received for a finished collectors PipelineRun", func() {
+			releasePipelineRun.AsPipelineRun().Status.InitializeConditions(clock.RealClock{})
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName).WithCollectors(nil)
+			contextEvent := event.UpdateEvent{
+				ObjectOld: releasePipelineRun.AsPipelineRun(),
+				ObjectNew: releasePipelineRun.AsPipelineRun(),
+			}
+			releasePipelineRun.Status.MarkRunning("Predicate function tests", "Set it to Unknown")
+			Expect(instance.Update(contextEvent)).To(BeFalse())
+			releasePipelineRun.Status.MarkFailed("Predicate function tests", "Set it to Failed")
+			Expect(instance.Update(contextEvent)).To(BeTrue())
+		})
+
+		It("should return true when an updated event is received for a release PipelineRun externally cancelled", func() {
+			releasePipelineRun.AsPipelineRun().Status.InitializeConditions(clock.RealClock{})
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName)
+			cancelledPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			cancelledPipelineRun.Spec.Status = tektonv1beta1.PipelineRunSpecStatusCancelled
+			contextEvent := event.UpdateEvent{
+				ObjectOld: releasePipelineRun.AsPipelineRun(),
+				ObjectNew: cancelledPipelineRun,
+			}
+			Expect(instance.Update(contextEvent)).To(BeTrue())
+		})
 	})
 })
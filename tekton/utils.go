@@ -17,6 +17,12 @@ limitations under the License.
 package tekton
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"knative.dev/pkg/apis"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,6 +40,18 @@ func isReleasePipelineRun(object client.Object) bool {
 	return found && labelValue == PipelineTypeRelease
 }
 
+// isCollectorsPipelineRun returns a boolean indicating whether the object passed is a collectors PipelineRun or not.
+func isCollectorsPipelineRun(object client.Object) bool {
+	_, ok := object.(*tektonv1beta1.PipelineRun)
+	if !ok {
+		return false
+	}
+
+	labelValue, found := object.GetLabels()[PipelinesTypeLabel]
+
+	return found && labelValue == PipelineTypeCollectors
+}
+
 // hasPipelineSucceeded returns a boolean indicating whether the PipelineRun succeeded or not.
 // If the object passed to this function is not a PipelineRun, the function will return false.
 func hasPipelineSucceeded(object client.Object) bool {
@@ -43,3 +61,145 @@ func hasPipelineSucceeded(object client.Object) bool {
 
 	return false
 }
+
+// hasSpecStatusChanged returns a boolean indicating whether the spec.status field changed between the two objects passed.
+// This field is set when a PipelineRun is externally paused or cancelled. If the objects passed to this function
+// are not PipelineRuns, the function will return false.
+func hasSpecStatusChanged(objectOld, objectNew client.Object) bool {
+	oldPipelineRun, ok := objectOld.(*tektonv1beta1.PipelineRun)
+	if !ok {
+		return false
+	}
+
+	if newPipelineRun, ok := objectNew.(*tektonv1beta1.PipelineRun); ok {
+		return oldPipelineRun.Spec.Status != newPipelineRun.Spec.Status
+	}
+
+	return false
+}
+
+// hasPipelineRefChanged returns a boolean indicating whether the pipeline referenced by the two objects passed differs.
+// If the objects passed to this function are not PipelineRuns, the function will return false.
+func hasPipelineRefChanged(objectOld, objectNew client.Object) bool {
+	oldPipelineRun, ok := objectOld.(*tektonv1beta1.PipelineRun)
+	if !ok {
+		return false
+	}
+
+	if newPipelineRun, ok := objectNew.(*tektonv1beta1.PipelineRun); ok {
+		return GetPipelineReference(oldPipelineRun) != GetPipelineReference(newPipelineRun)
+	}
+
+	return false
+}
+
+// hasStatusMessageChanged returns a boolean indicating whether the message of the Succeeded condition differs between
+// the two objects passed. If the objects passed to this function are not PipelineRuns, the function will return false.
+func hasStatusMessageChanged(objectOld, objectNew client.Object) bool {
+	oldPipelineRun, ok := objectOld.(*tektonv1beta1.PipelineRun)
+	if !ok {
+		return false
+	}
+
+	if newPipelineRun, ok := objectNew.(*tektonv1beta1.PipelineRun); ok {
+		return GetStatusMessage(oldPipelineRun) != GetStatusMessage(newPipelineRun)
+	}
+
+	return false
+}
+
+// GetStatusMessage returns the message of the Succeeded condition of the given PipelineRun as reported by Tekton. If
+// the PipelineRun doesn't have that condition yet, an empty string is returned.
+func GetStatusMessage(pipelineRun *tektonv1beta1.PipelineRun) string {
+	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil {
+		return ""
+	}
+
+	return condition.Message
+}
+
+// GetPipelineReference returns a string identifying the pipeline referenced by the given PipelineRun. Pipelines
+// resolved from a bundle are identified by the bundle and the pipeline name separated by a '#' character. If the
+// PipelineRun doesn't reference a pipeline, an empty string is returned.
+func GetPipelineReference(pipelineRun *tektonv1beta1.PipelineRun) string {
+	pipelineRef := pipelineRun.Spec.PipelineRef
+	if pipelineRef == nil {
+		return ""
+	}
+
+	if pipelineRef.Name != "" {
+		if pipelineRef.Bundle != "" {
+			return fmt.Sprintf("%s#%s", pipelineRef.Bundle, pipelineRef.Name)
+		}
+		return pipelineRef.Name
+	}
+
+	var bundle, name string
+	for _, param := range pipelineRef.Params {
+		switch param.Name {
+		case "bundle":
+			bundle = param.Value.StringVal
+		case "name":
+			name = param.Value.StringVal
+		}
+	}
+
+	return fmt.Sprintf("%s#%s", bundle, name)
+}
+
+// HasChainsProvenance returns a boolean indicating whether Tekton Chains signed the given PipelineRun.
+func HasChainsProvenance(pipelineRun *tektonv1beta1.PipelineRun) bool {
+	return pipelineRun.GetAnnotations()[ChainsSignedAnnotation] == "true"
+}
+
+// GetInputsHash returns a hash of the namespace and spec of the given PipelineRun, so two PipelineRuns running the
+// same pipeline with the same params in the same namespace have the same hash. The release param is left out, as it
+// differs for every Release even if their inputs are the same. The hash is a sha224 hex string, so it can be used as a
+// label value.
+func GetInputsHash(pipelineRun *tektonv1beta1.PipelineRun) string {
+	inputs := pipelineRun.Spec.DeepCopy()
+	inputs.Params = nil
+	for _, param := range pipelineRun.Spec.Params {
+		if param.Name != ReleaseParamName {
+			inputs.Params = append(inputs.Params, param)
+		}
+	}
+
+	// The spec is a plain structure, so no error should be raised when marshalling it
+	spec, _ := json.Marshal(inputs)
+
+	return fmt.Sprintf("%x", sha256.Sum224(append([]byte(pipelineRun.Namespace+"/"), spec...)))
+}
+
+// GetParamsSize returns the size in bytes of the serialized params of the given PipelineRun.
+func GetParamsSize(pipelineRun *tektonv1beta1.PipelineRun) int {
+	if len(pipelineRun.Spec.Params) == 0 {
+		return 0
+	}
+
+	// Params are plain structures, so no error should be raised when marshalling them
+	params, _ := json.Marshal(pipelineRun.Spec.Params)
+
+	return len(params)
+}
+
+// ConvertToAPIVersion returns the given PipelineRun as an object of the given Tekton API version, so it can be created
+// in clusters serving just one of them. For v1beta1, the PipelineRun passed is returned as is. An error is returned if
+// the API version is not supported or the conversion fails.
+func ConvertToAPIVersion(pipelineRun *tektonv1beta1.PipelineRun, apiVersion string) (client.Object, error) {
+	switch apiVersion {
+	case APIVersionV1beta1:
+		return pipelineRun, nil
+	case APIVersionV1:
+		convertedPipelineRun := &tektonv1.PipelineRun{}
+		if err := pipelineRun.ConvertTo(context.Background(), convertedPipelineRun); err != nil {
+			return nil, err
+		}
+		convertedPipelineRun.SetGroupVersionKind(tektonv1.SchemeGroupVersion.WithKind("PipelineRun"))
+
+		return convertedPipelineRun, nil
+	default:
+		return nil, fmt.Errorf("unsupported Tekton API version %q", apiVersion)
+	}
+}
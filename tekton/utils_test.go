@@ -18,6 +18,7 @@ package tekton
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 
 	"k8s.io/utils/clock"
@@ -26,6 +27,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/redhat-appstudio/release-service/api/v1alpha1"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -91,6 +94,13 @@ var _ = Describe("Utils", func() {
 				AsPipelineRun())).To(Equal(true))
 		})
 
+		It("returns true when the PipelineRun is a collectors PipelineRun or false otherwise", func() {
+			Expect(isCollectorsPipelineRun(release)).To(BeFalse())
+			releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName)
+			Expect(isCollectorsPipelineRun(releasePipelineRun.AsPipelineRun())).To(BeFalse())
+			Expect(isCollectorsPipelineRun(releasePipelineRun.WithCollectors(nil).AsPipelineRun())).To(BeTrue())
+		})
+
 		It("returns true when ReleasePipelineRun.Status is `Succeeded` or false otherwise", func() {
 			releasePipelineRun.AsPipelineRun().Status.InitializeConditions(clock.RealClock{})
 			// MarkRunning sets Status to Unknown
@@ -99,5 +109,142 @@ var _ = Describe("Utils", func() {
 			releasePipelineRun.Status.MarkSucceeded("PipelineRun Tests", "sets it to Succeeded")
 			Expect(hasPipelineSucceeded(releasePipelineRun.AsPipelineRun())).Should(BeTrue())
 		})
+
+		It("returns true when the spec.status of the PipelineRun changed or false otherwise", func() {
+			oldPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			newPipelineRun := oldPipelineRun.DeepCopy()
+			Expect(hasSpecStatusChanged(oldPipelineRun, newPipelineRun)).To(BeFalse())
+			newPipelineRun.Spec.Status = tektonv1beta1.PipelineRunSpecStatusCancelled
+			Expect(hasSpecStatusChanged(oldPipelineRun, newPipelineRun)).To(BeTrue())
+			Expect(hasSpecStatusChanged(release, newPipelineRun)).To(BeFalse())
+		})
+
+		It("returns true when the pipeline referenced by the PipelineRun changed or false otherwise", func() {
+			oldPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			oldPipelineRun.Spec.PipelineRef = &tektonv1beta1.PipelineRef{Name: "release-pipeline"}
+			newPipelineRun := oldPipelineRun.DeepCopy()
+			Expect(hasPipelineRefChanged(oldPipelineRun, newPipelineRun)).To(BeFalse())
+			newPipelineRun.Spec.PipelineRef.Name = "other-pipeline"
+			Expect(hasPipelineRefChanged(oldPipelineRun, newPipelineRun)).To(BeTrue())
+			Expect(hasPipelineRefChanged(release, newPipelineRun)).To(BeFalse())
+		})
+
+		It("returns true when the status message of the PipelineRun changed or false otherwise", func() {
+			oldPipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			oldPipelineRun.Status.MarkRunning("Running", "Tasks Completed: 1 (Failed: 0, Cancelled 0), Incomplete: 2, Skipped: 0")
+			newPipelineRun := oldPipelineRun.DeepCopy()
+			Expect(hasStatusMessageChanged(oldPipelineRun, newPipelineRun)).To(BeFalse())
+			newPipelineRun.Status.MarkRunning("Running", "Tasks Completed: 2 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0")
+			Expect(hasStatusMessageChanged(oldPipelineRun, newPipelineRun)).To(BeTrue())
+			Expect(hasStatusMessageChanged(release, newPipelineRun)).To(BeFalse())
+		})
+
+		It("returns the message of the Succeeded condition of the PipelineRun", func() {
+			pipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			Expect(GetStatusMessage(pipelineRun)).To(BeEmpty())
+			pipelineRun.Status.MarkSucceeded("Succeeded", "Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1")
+			Expect(GetStatusMessage(pipelineRun)).To(Equal("Tasks Completed: 3 (Failed: 0, Cancelled 0), Skipped: 1"))
+		})
+
+		It("returns the reference of the pipeline referenced by name", func() {
+			pipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			Expect(GetPipelineReference(pipelineRun)).To(BeEmpty())
+			pipelineRun.Spec.PipelineRef = &tektonv1beta1.PipelineRef{Name: "release-pipeline"}
+			Expect(GetPipelineReference(pipelineRun)).To(Equal("release-pipeline"))
+		})
+
+		It("returns the reference of the pipeline resolved from a bundle", func() {
+			pipelineRun := releasePipelineRun.AsPipelineRun().DeepCopy()
+			pipelineRun.Spec.PipelineRef = &tektonv1beta1.PipelineRef{
+				ResolverRef: getBundleResolver("quay.io/org/bundle:tag", "pipeline", "release-pipeline"),
+			}
+			Expect(GetPipelineReference(pipelineRun)).To(Equal("quay.io/org/bundle:tag#release-pipeline"))
+		})
+
+		It("returns true when the PipelineRun was signed by Tekton Chains or false otherwise", func() {
+			Expect(HasChainsProvenance(releasePipelineRun.AsPipelineRun())).To(BeFalse())
+			releasePipelineRun.Annotations = map[string]string{ChainsSignedAnnotation: "true"}
+			Expect(HasChainsProvenance(releasePipelineRun.AsPipelineRun())).To(BeTrue())
+		})
+
+		It("returns zero as the params size when the PipelineRun has no params", func() {
+			Expect(GetParamsSize(releasePipelineRun.AsPipelineRun())).To(Equal(0))
+		})
+
+		It("returns the size of the serialized params when the PipelineRun has params", func() {
+			releasePipelineRun.WithExtraParam("foo", tektonv1beta1.ArrayOrString{
+				Type:      tektonv1beta1.ParamTypeString,
+				StringVal: "bar",
+			})
+			params, _ := json.Marshal(releasePipelineRun.Spec.Params)
+			Expect(GetParamsSize(releasePipelineRun.AsPipelineRun())).To(Equal(len(params)))
+		})
+
+		It("returns the same inputs hash for PipelineRuns with the same namespace and spec", func() {
+			pipelineRun := releasePipelineRun.WithReleaseAndApplicationMetadata(release, applicationName).AsPipelineRun()
+			identicalPipelineRun := NewReleasePipelineRun("other-pipeline", pipelineRun.Namespace).AsPipelineRun()
+			identicalPipelineRun.Spec = *pipelineRun.Spec.DeepCopy()
+			Expect(GetInputsHash(pipelineRun)).To(HaveLen(56))
+			Expect(GetInputsHash(identicalPipelineRun)).To(Equal(GetInputsHash(pipelineRun)))
+		})
+
+		It("returns a different inputs hash for PipelineRuns with different params or namespaces", func() {
+			pipelineRun := releasePipelineRun.AsPipelineRun()
+			otherPipelineRun := pipelineRun.DeepCopy()
+			otherPipelineRun.Namespace = "other-namespace"
+			Expect(GetInputsHash(otherPipelineRun)).NotTo(Equal(GetInputsHash(pipelineRun)))
+
+			otherPipelineRun = pipelineRun.DeepCopy()
+			otherPipelineRun.Spec.Params = append(otherPipelineRun.Spec.Params, tektonv1beta1.Param{
+				Name:  "foo",
+				Value: *tektonv1beta1.NewStructuredValues("bar"),
+			})
+			Expect(GetInputsHash(otherPipelineRun)).NotTo(Equal(GetInputsHash(pipelineRun)))
+		})
+
+		It("returns the same inputs hash for PipelineRuns only differing in their release param", func() {
+			pipelineRun := releasePipelineRun.AsPipelineRun()
+			otherPipelineRun := pipelineRun.DeepCopy()
+			otherPipelineRun.Spec.Params = append(otherPipelineRun.Spec.Params, tektonv1beta1.Param{
+				Name:  ReleaseParamName,
+				Value: *tektonv1beta1.NewStructuredValues("default/other-release"),
+			})
+			Expect(GetInputsHash(otherPipelineRun)).To(Equal(GetInputsHash(pipelineRun)))
+		})
+
+		It("returns the same PipelineRun when converting it to the v1beta1 API version", func() {
+			object, err := ConvertToAPIVersion(releasePipelineRun.AsPipelineRun(), APIVersionV1beta1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(object).To(BeIdenticalTo(releasePipelineRun.AsPipelineRun()))
+		})
+
+		It("returns a v1 PipelineRun when converting it to the v1 API version", func() {
+			releasePipelineRun.WithServiceAccount("test-service-account").
+				WithReleaseAndApplicationMetadata(release, applicationName).
+				WithExtraParam("foo", tektonv1beta1.ArrayOrString{
+					Type:      tektonv1beta1.ParamTypeString,
+					StringVal: "bar",
+				})
+
+			object, err := ConvertToAPIVersion(releasePipelineRun.AsPipelineRun(), APIVersionV1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(object.GetObjectKind().GroupVersionKind()).To(Equal(tektonv1.SchemeGroupVersion.WithKind("PipelineRun")))
+
+			pipelineRun, ok := object.(*tektonv1.PipelineRun)
+			Expect(ok).To(BeTrue())
+			Expect(pipelineRun.Name).To(Equal(releasePipelineRun.Name))
+			Expect(pipelineRun.Namespace).To(Equal(releasePipelineRun.Namespace))
+			Expect(pipelineRun.Labels).To(Equal(releasePipelineRun.Labels))
+			Expect(pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("test-service-account"))
+			Expect(pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(pipelineRun.Spec.Params[0].Name).To(Equal("foo"))
+			Expect(pipelineRun.Spec.Params[0].Value.StringVal).To(Equal("bar"))
+		})
+
+		It("fails to convert the PipelineRun to an unsupported API version", func() {
+			object, err := ConvertToAPIVersion(releasePipelineRun.AsPipelineRun(), "v2")
+			Expect(err).To(HaveOccurred())
+			Expect(object).To(BeNil())
+		})
 	})
 })